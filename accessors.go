@@ -0,0 +1,196 @@
+package uri
+
+import "strings"
+
+// Transport is value of transport URI parameter.
+type Transport uint8
+
+// Transports of RFC3261 transport-param and RFC7118 WebSocket transports.
+const (
+	TransportNone Transport = iota // no transport parameter
+	TransportUDP
+	TransportTCP
+	TransportSCTP
+	TransportTLS
+	TransportWS
+	TransportWSS
+	TransportOther // other-transport token
+)
+
+var transportNames = [...]string{
+	TransportNone:  "",
+	TransportUDP:   "udp",
+	TransportTCP:   "tcp",
+	TransportSCTP:  "sctp",
+	TransportTLS:   "tls",
+	TransportWS:    "ws",
+	TransportWSS:   "wss",
+	TransportOther: "other",
+}
+
+// String returns lower case transport name.
+func (t Transport) String() string {
+	if int(t) < len(transportNames) {
+		return transportNames[t]
+	}
+	return "other"
+}
+
+// UserParam is value of user URI parameter.
+type UserParam uint8
+
+// Values of RFC3261 user-param and RFC4967 dialstring.
+const (
+	UserNone  UserParam = iota // no user parameter
+	UserPhone                  // user part is telephone-subscriber
+	UserIP
+	UserDialstring
+	UserOther // other-user token
+)
+
+var userParamNames = [...]string{
+	UserNone:       "",
+	UserPhone:      "phone",
+	UserIP:         "ip",
+	UserDialstring: "dialstring",
+	UserOther:      "other",
+}
+
+// String returns lower case user parameter value.
+func (u UserParam) String() string {
+	if int(u) < len(userParamNames) {
+		return userParamNames[u]
+	}
+	return "other"
+}
+
+// transport-param = "transport=" ( "udp" / "tcp" / "sctp" / "tls" / other-transport)
+// other-transport = token
+//
+// Transport returns TransportNone when parameter is not present and
+// ValidationError when value is not a token.
+func (uri *URI) Transport() (Transport, error) {
+	value, ok := uri.param("transport")
+	if !ok {
+		return TransportNone, nil
+	}
+	for t := TransportUDP; t < TransportOther; t++ {
+		if strings.EqualFold(value, transportNames[t]) {
+			return t, nil
+		}
+	}
+	if !isToken(value) {
+		return TransportNone, &ValidationError{"transport", value}
+	}
+	return TransportOther, nil
+}
+
+// user-param  = "user=" ( "phone" / "ip" / other-user)
+// other-user  = token
+//
+// UserParam returns UserNone when parameter is not present and
+// ValidationError when value is not a token.
+func (uri *URI) UserParam() (UserParam, error) {
+	value, ok := uri.param("user")
+	if !ok {
+		return UserNone, nil
+	}
+	for u := UserPhone; u < UserOther; u++ {
+		if strings.EqualFold(value, userParamNames[u]) {
+			return u, nil
+		}
+	}
+	if !isToken(value) {
+		return UserNone, &ValidationError{"user", value}
+	}
+	return UserOther, nil
+}
+
+// method-param = "method=" Method
+// Method       = INVITEm / ACKm / OPTIONSm / BYEm / CANCELm / REGISTERm / extension-method
+// extension-method = token
+//
+// Method returns empty string when parameter is not present and
+// ValidationError when value is not a token. Method is case-sensitive.
+func (uri *URI) Method() (string, error) {
+	value, ok := uri.param("method")
+	if !ok {
+		return "", nil
+	}
+	if !isToken(value) {
+		return "", &ValidationError{"method", value}
+	}
+	return value, nil
+}
+
+// ttl-param = "ttl=" ttl
+// ttl       = 1*3DIGIT ; 0 to 255
+//
+// TTL returns multicast time to live and true when parameter is present.
+// ValidationError is returned when value is out of range.
+func (uri *URI) TTL() (int, bool, error) {
+	value, ok := uri.param("ttl")
+	if !ok {
+		return 0, false, nil
+	}
+	n, c, ok := dtoi(value)
+	if !ok || c != len(value) || c > 3 || n > 255 {
+		return 0, false, &ValidationError{"ttl", value}
+	}
+	return n, true, nil
+}
+
+// maddr-param = "maddr=" host
+//
+// MAddr returns host of maddr parameter, IPv6 reference without brackets.
+// Empty string is returned when parameter is not present and
+// ValidationError when value is not a host.
+func (uri *URI) MAddr() (string, error) {
+	value, ok := uri.param("maddr")
+	if !ok {
+		return "", nil
+	}
+	if !isHost(value) {
+		return "", &ValidationError{"maddr", value}
+	}
+	return strings.TrimSuffix(strings.TrimPrefix(value, "["), "]"), nil
+}
+
+// lr-param = "lr"
+//
+// LR reports loose routing flag.
+func (uri *URI) LR() bool {
+	_, ok := uri.param("lr")
+	return ok
+}
+
+// host = hostname / IPv4address / IPv6reference
+func isHost(s string) bool {
+	if strings.HasPrefix(s, "[") && strings.HasSuffix(s, "]") {
+		return isIPv6(s[1 : len(s)-1])
+	}
+	if n, ok := parseIPv4(s); ok && n == len(s) {
+		return true
+	}
+	return isDomainname(s)
+}
+
+// hostport = host [ ":" port ]
+func isHostport(s string) bool {
+	host, port := splitHostport(s)
+	if port == "" {
+		return host == s && isHost(host)
+	}
+	n, c, ok := dtoi(port)
+	return ok && c == len(port) && n <= 0xFFFF && isHost(host)
+}
+
+// token = 1*(alphanum / "-" / "." / "!" / "%" / "*" / "_" / "+" / "`" / "'" / "~" )
+func isToken(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if !isTokenChar(s[i]) {
+			return false
+		}
+	}
+	return s != ""
+}
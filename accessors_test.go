@@ -0,0 +1,143 @@
+package uri
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransport(t *testing.T) {
+	tests := []struct {
+		input     string
+		transport Transport
+	}{
+		{"sip:alice@atlanta.com", TransportNone},
+		{"sip:alice@atlanta.com;transport=udp", TransportUDP},
+		{"sip:alice@atlanta.com;transport=TCP", TransportTCP},
+		{"sip:alice@atlanta.com;transport=sctp", TransportSCTP},
+		{"sips:alice@atlanta.com;transport=tls", TransportTLS},
+		{"sip:alice@atlanta.com;transport=ws", TransportWS},
+		{"sip:alice@atlanta.com;transport=WSS", TransportWSS},
+		{"sip:alice@atlanta.com;transport=quic", TransportOther},
+	}
+	for _, tc := range tests {
+		uri, _ := Parse(tc.input)
+		transport, err := uri.Transport()
+		assert.Nil(t, err, tc.input)
+		assert.Equal(t, tc.transport, transport, tc.input)
+	}
+	assert.Equal(t, "wss", TransportWSS.String())
+
+	uri, _ := Parse("sip:alice@atlanta.com;transport=u/dp")
+	_, err := uri.Transport()
+	assert.Equal(t, &ValidationError{"transport", "u/dp"}, err)
+}
+
+func TestUserParam(t *testing.T) {
+	tests := []struct {
+		input string
+		user  UserParam
+	}{
+		{"sip:alice@atlanta.com", UserNone},
+		{"sip:+1-212-555-1212@gateway.com;user=phone", UserPhone},
+		{"sip:alice@atlanta.com;user=IP", UserIP},
+		{"sip:*67*123@atlanta.com;user=dialstring", UserDialstring},
+		{"sip:alice@atlanta.com;user=foo", UserOther},
+	}
+	for _, tc := range tests {
+		uri, _ := Parse(tc.input)
+		user, err := uri.UserParam()
+		assert.Nil(t, err, tc.input)
+		assert.Equal(t, tc.user, user, tc.input)
+	}
+
+	uri, _ := Parse("sip:alice@atlanta.com;user=[phone]")
+	_, err := uri.UserParam()
+	assert.Equal(t, &ValidationError{"user", "[phone]"}, err)
+}
+
+func TestMethod(t *testing.T) {
+	uri, _ := Parse("sip:atlanta.com;method=REGISTER")
+	method, err := uri.Method()
+	assert.Nil(t, err)
+	assert.Equal(t, "REGISTER", method)
+
+	uri, _ = Parse("sip:atlanta.com")
+	method, err = uri.Method()
+	assert.Nil(t, err)
+	assert.Equal(t, "", method)
+
+	uri, _ = Parse("sip:atlanta.com;method=REGISTER%20")
+	_, err = uri.Method()
+	assert.Equal(t, &ValidationError{"method", "REGISTER "}, err)
+}
+
+func TestTTL(t *testing.T) {
+	for input, expect := range map[string]int{
+		"sip:atlanta.com;ttl=0":   0,
+		"sip:atlanta.com;ttl=1":   1,
+		"sip:atlanta.com;ttl=255": 255,
+		"sip:atlanta.com;ttl=015": 15,
+	} {
+		uri, _ := Parse(input)
+		ttl, ok, err := uri.TTL()
+		assert.Nil(t, err, input)
+		assert.True(t, ok, input)
+		assert.Equal(t, expect, ttl, input)
+	}
+
+	uri, _ := Parse("sip:atlanta.com")
+	_, ok, err := uri.TTL()
+	assert.Nil(t, err)
+	assert.False(t, ok)
+
+	for _, value := range []string{"999", "256", "0001", "-1", "1a", ""} {
+		uri, err := Parse("sip:atlanta.com;ttl=" + value)
+		if value == "" {
+			uri, err = Parse("sip:atlanta.com;ttl")
+		}
+		assert.Nil(t, err, value)
+		_, ok, err := uri.TTL()
+		assert.False(t, ok, value)
+		assert.Equal(t, &ValidationError{"ttl", value}, err, value)
+
+		var verr *ValidationError
+		assert.True(t, errors.As(err, &verr))
+		var perr *ParseError
+		assert.False(t, errors.As(err, &perr))
+	}
+}
+
+func TestMAddr(t *testing.T) {
+	for input, expect := range map[string]string{
+		"sip:atlanta.com":                         "",
+		"sip:atlanta.com;maddr=239.255.255.1":     "239.255.255.1",
+		"sip:atlanta.com;maddr=[ff02::1]":         "ff02::1",
+		"sip:atlanta.com;maddr=proxy.atlanta.com": "proxy.atlanta.com",
+	} {
+		uri, _ := Parse(input)
+		maddr, err := uri.MAddr()
+		assert.Nil(t, err, input)
+		assert.Equal(t, expect, maddr, input)
+	}
+
+	for _, value := range []string{"999.1.1.1", "[::1", "a_b.com", "-a.com", "1.2.3"} {
+		uri, err := Parse("sip:atlanta.com;maddr=" + value)
+		assert.Nil(t, err, value)
+		_, err = uri.MAddr()
+		assert.Equal(t, &ValidationError{"maddr", value}, err, value)
+	}
+}
+
+func TestLR(t *testing.T) {
+	uri, _ := Parse("sip:p1.example.com;lr")
+	assert.True(t, uri.LR())
+	uri, _ = Parse("sip:p1.example.com;transport=tcp")
+	assert.False(t, uri.LR())
+}
+
+func TestValidationError(t *testing.T) {
+	err := &ValidationError{"ttl", "999"}
+	assert.Equal(t, "Invalid URI parameter ttl value '999'", err.Error())
+}
@@ -0,0 +1,6 @@
+//go:build uri_lexer && !uri_re2go
+// +build uri_lexer,!uri_re2go
+
+package uri
+
+const defaultBackend = "lexer"
@@ -0,0 +1,6 @@
+//go:build !uri_re2go && !uri_lexer
+// +build !uri_re2go,!uri_lexer
+
+package uri
+
+const defaultBackend = "ragel"
@@ -0,0 +1,6 @@
+//go:build uri_re2go
+// +build uri_re2go
+
+package uri
+
+const defaultBackend = "re2go"
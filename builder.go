@@ -0,0 +1,118 @@
+package uri
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Builder constructs sip or sips URI from unescaped components. Characters
+// not allowed in a component are escaped, so for example "@" or ";" in
+// user can not change URI structure. Host, port and names of parameters
+// and headers are validated, the first error is reported by URI.
+//
+//	u, err := uri.NewBuilder(uri.SIP, "atlanta.com").
+//		User("alice").
+//		Port(5060).
+//		Param("transport", "tcp").
+//		Header("subject", "project x").
+//		URI()
+type Builder struct {
+	scheme      Scheme
+	user        string
+	password    string
+	hasPassword bool
+	host        string
+	port        int
+	params      Params
+	headers     Headers
+	err         error
+}
+
+// NewBuilder returns Builder of URI with the scheme and host. Host is
+// hostname, IPv4 address or IPv6 address with or without brackets.
+func NewBuilder(scheme Scheme, host string) *Builder {
+	b := &Builder{scheme: scheme, host: host, port: -1}
+	if scheme != SIP && scheme != SIPS {
+		b.err = fmt.Errorf("%w: %s", ErrInvalidScheme, scheme)
+		return b
+	}
+	if isIPv6(host) {
+		b.host = "[" + host + "]"
+	}
+	if !isHost(b.host) {
+		b.err = fmt.Errorf("%w: '%s'", ErrInvalidHostport, host)
+	}
+	return b
+}
+
+// User sets user part of userinfo.
+func (b *Builder) User(user string) *Builder {
+	b.user = user
+	return b
+}
+
+// Password sets password part of userinfo. Password requires user.
+func (b *Builder) Password(password string) *Builder {
+	b.password, b.hasPassword = password, true
+	return b
+}
+
+// Port sets port 0-65535.
+func (b *Builder) Port(port int) *Builder {
+	if b.err == nil && (port < 0 || port > 0xFFFF) {
+		b.err = fmt.Errorf("%w: %d", ErrInvalidPort, port)
+	}
+	b.port = port
+	return b
+}
+
+// Param adds URI parameter. Empty value adds parameter without value
+// like "lr".
+func (b *Builder) Param(name, value string) *Builder {
+	if b.err == nil && name == "" {
+		b.err = fmt.Errorf("%w: empty parameter name", ErrInvalidParams)
+	}
+	b.params = append(b.params, Param{name, value})
+	return b
+}
+
+// Header adds URI header.
+func (b *Builder) Header(name, value string) *Builder {
+	if b.err == nil && name == "" {
+		b.err = fmt.Errorf("%w: empty header name", ErrInvalidHeaders)
+	}
+	b.headers = append(b.headers, Header{name, value})
+	return b
+}
+
+// URI returns built URI or the first error.
+func (b *Builder) URI() (*URI, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	if b.user == "" && b.hasPassword {
+		return nil, fmt.Errorf("%w: password without user", ErrInvalidUserinfo)
+	}
+
+	uri := &URI{scheme: b.scheme, hostport: b.host}
+	if b.user != "" {
+		buf := appendEscaped(nil, b.user, isBuilderUserChar)
+		if b.hasPassword {
+			buf = append(buf, ':')
+			buf = appendEscaped(buf, b.password, isPasswordChar)
+		}
+		uri.userinfo = string(buf)
+	}
+	if b.port >= 0 {
+		uri.hostport += ":" + strconv.Itoa(b.port)
+	}
+	uri.setParams(b.params.String())
+	uri.headers = b.headers.String()
+	return uri, nil
+}
+
+// isBuilderUserChar is user-unreserved without ";" and "?", those are
+// escaped so lenient parsers can not take them as params or headers.
+func isBuilderUserChar(c byte) bool {
+	return c != ';' && c != '?' && isUserChar(c)
+}
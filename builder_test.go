@@ -0,0 +1,87 @@
+package uri
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuilder(t *testing.T) {
+	tests := []struct {
+		builder *Builder
+		uri     string
+	}{
+		{NewBuilder(SIP, "atlanta.com"), "sip:atlanta.com"},
+		{NewBuilder(SIPS, "atlanta.com").User("alice").Port(5061), "sips:alice@atlanta.com:5061"},
+		{NewBuilder(SIP, "192.0.2.4").User("alice").Password("secret word"), "sip:alice:secret%20word@192.0.2.4"},
+		{NewBuilder(SIP, "2001:db8::10").Port(5070), "sip:[2001:db8::10]:5070"},
+		{NewBuilder(SIP, "[::1]"), "sip:[::1]"},
+		{NewBuilder(SIP, "atlanta.com").User("bob@evil.com;lr?x=y:z"), "sip:bob%40evil.com%3Blr%3Fx=y%3Az@atlanta.com"},
+		{NewBuilder(SIP, "atlanta.com").User("alice").Password("p@ss:;"), "sip:alice:p%40ss%3A%3B@atlanta.com"},
+		{NewBuilder(SIP, "atlanta.com").Param("transport", "tcp").Param("lr", "").Param("x", "a;b=c"),
+			"sip:atlanta.com;transport=tcp;lr;x=a%3Bb%3Dc"},
+		{NewBuilder(SIP, "atlanta.com").Header("subject", "project x").Header("to", "bob&eve=1"),
+			"sip:atlanta.com?subject=project%20x&to=bob%26eve%3D1"},
+		{NewBuilder(SIP, "atlanta.com").User("+1-212-555-1212").Port(0).Param("user", "phone"),
+			"sip:+1-212-555-1212@atlanta.com:0;user=phone"},
+	}
+
+	for _, tc := range tests {
+		uri, err := tc.builder.URI()
+		if !assert.Nil(t, err, tc.uri) {
+			continue
+		}
+		assert.Equal(t, tc.uri, uri.String())
+
+		for _, name := range []string{"ragel", "re2go", "lexer"} {
+			parser, _ := Lookup(name)
+			parsed, err := parser.Parse(uri.String())
+			if assert.Nil(t, err, "%s: %s", name, tc.uri) {
+				assert.Equal(t, uri, parsed, "%s: %s", name, tc.uri)
+			}
+		}
+	}
+}
+
+func TestBuilderComponents(t *testing.T) {
+	uri, err := NewBuilder(SIP, "atlanta.com").
+		User("bob@evil.com").
+		Password("a:b").
+		Param("x", "a;b").
+		Header("subject", "a&b").
+		URI()
+	assert.Nil(t, err)
+	assert.Equal(t, "bob@evil.com", uri.User())
+	passwd, _ := uri.Password()
+	assert.Equal(t, "a:b", passwd)
+	assert.Equal(t, "atlanta.com", uri.Host())
+	assert.Equal(t, Params{{"x", "a;b"}}, uri.Params())
+	assert.Equal(t, Headers{{"subject", "a&b"}}, uri.Headers())
+}
+
+func TestBuilderFail(t *testing.T) {
+	tests := []struct {
+		builder *Builder
+		err     error
+	}{
+		{NewBuilder(UNKNOWN, "atlanta.com"), ErrInvalidScheme},
+		{NewBuilder(TEL, "atlanta.com"), ErrInvalidScheme},
+		{NewBuilder(SIP, ""), ErrInvalidHostport},
+		{NewBuilder(SIP, "atlanta.com:5060"), ErrInvalidHostport},
+		{NewBuilder(SIP, "evil.com;lr"), ErrInvalidHostport},
+		{NewBuilder(SIP, "alice@atlanta.com"), ErrInvalidHostport},
+		{NewBuilder(SIP, "[::1"), ErrInvalidHostport},
+		{NewBuilder(SIP, "atlanta.com").Port(65536), ErrInvalidPort},
+		{NewBuilder(SIP, "atlanta.com").Port(-2), ErrInvalidPort},
+		{NewBuilder(SIP, "atlanta.com").Password("secret"), ErrInvalidUserinfo},
+		{NewBuilder(SIP, "atlanta.com").Param("", "x"), ErrInvalidParams},
+		{NewBuilder(SIP, "atlanta.com").Header("", "x"), ErrInvalidHeaders},
+		{NewBuilder(SIP, "bad host").Port(70000).Param("", ""), ErrInvalidHostport},
+	}
+
+	for _, tc := range tests {
+		uri, err := tc.builder.URI()
+		assert.Nil(t, uri)
+		assert.ErrorIs(t, err, tc.err)
+	}
+}
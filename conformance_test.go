@@ -0,0 +1,285 @@
+package uri
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// grammar is a subset of RFC3261 SIP-URI grammar that corpus case
+// depends on. Backend result is checked only when backend supports
+// every grammar subset of the case.
+type grammar uint
+
+const (
+	gScheme     grammar = 1 << iota // only sip: and sips: schemes
+	gCharset                        // RFC3261 character classes of components
+	gEscaped                        // escaped = "%" HEXDIG HEXDIG
+	gParams                         // paramchar+ ( "=" paramchar+ )? separated by ";"
+	gHeaders                        // hname "=" hvalue separated by "&"
+	gIPv4Range                      // IPv4 octets are 0-255
+	gPortRange                      // port is 0-65535
+	gIPv6                           // RFC4291 IPv6 address
+	gTel                            // RFC3966 tel URI
+	gRFC3261    = gScheme | gCharset | gEscaped | gParams | gHeaders
+	gAnyGrammar = gScheme
+)
+
+var backends = []struct {
+	name     string
+	parse    func(string) (*URI, error)
+	supports grammar
+}{
+	{"ragel", RagelParse, gRFC3261 | gIPv4Range | gPortRange | gIPv6 | gTel},
+	{"re2go", Re2GoParse, gRFC3261 | gIPv4Range | gPortRange | gIPv6 | gTel},
+	{"lexer", LexerParse, gRFC3261 | gIPv4Range | gPortRange | gIPv6 | gTel},
+	{"dummy", DummyParser, gScheme},
+	{"regexp", RegexParse, gScheme},
+}
+
+// valid URIs with expected components (userinfo, hostport, params, headers).
+// RFC3261 #19.1.3 examples and RFC4475 torture tests.
+var validCorpus = []struct {
+	input                               string
+	userinfo, hostport, params, headers string
+}{
+	{"sip:alice@atlanta.com", "alice", "atlanta.com", "", ""},
+	{"sip:alice:secretword@atlanta.com;transport=tcp", "alice:secretword", "atlanta.com", "transport=tcp", ""},
+	{"sips:alice@atlanta.com?subject=project%20x&priority=urgent", "alice", "atlanta.com", "", "subject=project%20x&priority=urgent"},
+	{"sip:+1-212-555-1212:1234@gateway.com;user=phone", "+1-212-555-1212:1234", "gateway.com", "user=phone", ""},
+	{"sips:1212@gateway.com", "1212", "gateway.com", "", ""},
+	{"sip:alice@192.0.2.4", "alice", "192.0.2.4", "", ""},
+	{"sip:atlanta.com;method=REGISTER?to=alice%40atlanta.com", "", "atlanta.com", "method=REGISTER", "to=alice%40atlanta.com"},
+	{"sips:alice;day=tuesday@atlanta.com", "alice;day=tuesday", "atlanta.com", "", ""},
+	{"sip:alice@192.0.2.4:8899", "alice", "192.0.2.4:8899", "", ""},
+	{"sip:alice@255.255.255.255:65535", "alice", "255.255.255.255:65535", "", ""},
+	{"sip:alice@010.0.0.1:000005060", "alice", "010.0.0.1:000005060", "", ""},
+	{"sip:alice@1.2.3.a4:0", "alice", "1.2.3.a4:0", "", ""},
+	{"sip:vivekg@chair-dnrc.example.com;unknownparam", "vivekg", "chair-dnrc.example.com", "unknownparam", ""},
+	{"sip:vivekg@chair-dnrc.example.com:5060", "vivekg", "chair-dnrc.example.com:5060", "", ""},
+	{"sip:1_unusual.URI~(to-be!sure)&isn't+it$/crazy?,/;;*:&it+has=1,weird!*pas$wo~d_too.(doesn't-it)@example.com",
+		"1_unusual.URI~(to-be!sure)&isn't+it$/crazy?,/;;*:&it+has=1,weird!*pas$wo~d_too.(doesn't-it)", "example.com", "", ""},
+	{"sip:sips%3Auser%40example.com@example.net", "sips%3Auser%40example.com", "example.net", "", ""},
+	{"sip:%61lice@atlanta.com;transport=TCP", "%61lice", "atlanta.com", "transport=TCP", ""},
+	{"sip:[2001:db8::10]:5070", "", "[2001:db8::10]:5070", "", ""},
+	{"sip:user@[::ffff:192.0.2.1]", "user", "[::ffff:192.0.2.1]", "", ""},
+	{"sip:user@[::192.0.2.1]", "user", "[::192.0.2.1]", "", ""},
+	{"sip:[1:2:3:4:5:6:192.0.2.1]:5060", "", "[1:2:3:4:5:6:192.0.2.1]:5060", "", ""},
+	{"sip:[1:2:3:4:5:6:7::]", "", "[1:2:3:4:5:6:7::]", "", ""},
+	{"sip:user@example.com.", "user", "example.com.", "", ""},
+	{"sip:example.com;lr;maddr=[::1]", "", "example.com", "lr;maddr=[::1]", ""},
+	{"sip:example.com?Route=%3Csip:example.com%3E", "", "example.com", "", "Route=%3Csip:example.com%3E"},
+	{"sip:user@host?subject=", "user", "host", "", "subject="},
+	{"sip:user:@host", "user:", "host", "", ""},
+}
+
+// invalid URIs and grammar subset that rejects them.
+var invalidCorpus = []struct {
+	input string
+	needs grammar
+}{
+	{"", gScheme},
+	{"foo", gScheme},
+	{"sipsfoo", gScheme},
+	{"http://example.com", gScheme},
+	{"sip:", gAnyGrammar},
+	{"sip:1.1.1.1:a22", gCharset},
+	{"sip:atlanta.com;foo\"", gCharset},
+	{"sip:atlanta.com;foo?bar", gHeaders},
+	{"sip:atlanta.com?a=b&c", gHeaders},
+	{"sip:atlanta.com?", gHeaders},
+	{"sip:;foo?bar", gAnyGrammar},
+	{"sip:?foo", gAnyGrammar},
+	{"sip:alice@", gCharset},
+	{"sip:a b@c", gCharset},
+	{"sip:alice@atlanta.com;", gParams},
+	{"sip:alice@atlanta.com;lr=", gParams},
+	{"sip:alice@-atlanta.com", gCharset},
+	{"sip:alice@[::1", gCharset},
+	{"sip:alice@atlanta.com:", gCharset},
+	{"sip:%zz@atlanta.com", gEscaped},
+	{"sip:alice@atlanta.com;x=%2", gEscaped},
+	{"sip:<alice>@atlanta.com", gCharset},
+	{"sip:alice@atl_anta.com", gCharset},
+	{"sip:alice@8.8.8.256", gIPv4Range},
+	{"sip:alice@999.999.999.999", gIPv4Range},
+	{"sip:alice@atlanta.com:65536", gPortRange},
+	{"sip:alice@atlanta.com:123456", gPortRange},
+	{"sip:alice@atlanta.com:99999999999999999999", gPortRange},
+	{"sip:alice@256.0.0.1:5060", gIPv4Range},
+	{"sip:alice@[:::::]", gIPv6},
+	{"sip:alice@[1.2.3.4.5]", gIPv6},
+	{"sip:alice@[1:2:3:4:5:6:7:8:9]", gIPv6},
+	{"sip:alice@[1::2::3]", gIPv6},
+	{"sip:alice@[1::2:3:4:5:6:7:8]", gIPv6},
+	{"sip:alice@[1:2:3:4:5:6:7]", gIPv6},
+	{"sip:alice@[1:2:3:4:5:6:7:192.0.2.1]", gIPv6},
+	{"sip:alice@[::ffff:192.0.2.256]", gIPv6},
+	{"sip:alice@[::192.0.2]", gIPv6},
+	{"sip:alice@[12345::]", gIPv6},
+	{"sip:host;;lr", gParams},
+	{"sip:+1;postd=#@gw.com;user=phone", gCharset},
+	{"tel:", gTel},
+	{"tel:+", gTel},
+	{"tel:+1-201-555-0123;", gTel},
+	{"tel:+1-201-555-0123;ext=", gTel},
+	{"tel:+1-201-555-0123;ext=12a", gTel},
+	{"tel:+1-201-555-0123;phone-context=example.com", gTel},
+	{"tel:+1 201", gTel},
+	{"tel:1234", gTel},
+	{"tel:1234;phone-context=+1;phone-context=+2", gTel},
+	{"tel:1234;phone-context=-example.com", gTel},
+	{"tel:+1234;foo=%2", gTel},
+	{"tel:+1234;f_o=bar", gTel},
+	{"tel:+1234;ext=1;EXT=2", gTel},
+	{"tel:+1234;isub", gTel},
+	{"tel:+1234;postd=x", gTel},
+	{"tel:%2B1234", gTel},
+	{"tel:1234;Phone-Context=example.com;phone-context=example.com", gTel},
+	{"tel:*31#;phone-context=example.com", gTel},
+	{"tel:0;PHONE-CONTEXT=A;Postd=pp#00", gTel},
+	{"tel:+1234;phone-context=+1", gTel},
+	{"tel:+1234;ext=1;ext=2", gTel},
+	{"tel:+1;isub=a#", gTel},
+}
+
+// valid tel URIs with expected number and params.
+// RFC3966 examples.
+var telCorpus = []struct {
+	input, number, params string
+}{
+	{"tel:+1-201-555-0123", "+1-201-555-0123", ""},
+	{"tel:7042;phone-context=example.com", "7042", "phone-context=example.com"},
+	{"tel:863-1234;phone-context=+1-914-555", "863-1234", "phone-context=+1-914-555"},
+	{"tel:+1-201-555-0123;ext=1234;isub=a1%3B", "+1-201-555-0123", "ext=1234;isub=a1%3B"},
+	{"tel:*31%23;phone-context=example.com;foo=bar;flag", "*31%23", "phone-context=example.com;foo=bar;flag"},
+	{"tel:+(33)1.23.45.67.89", "+(33)1.23.45.67.89", ""},
+	{"TEL:+12015550123", "+12015550123", ""},
+	{"tel:*31%23;PHONE-CONTEXT=example.com;Postd=pp%23", "*31%23", "PHONE-CONTEXT=example.com;Postd=pp%23"},
+}
+
+type outcome struct {
+	uri *URI
+	err error
+}
+
+func (o outcome) String() string {
+	var perr *ParseError
+	if errors.As(o.err, &perr) {
+		return fmt.Sprintf("rejected: %v in %v", perr, perr.Component)
+	}
+	if o.err != nil {
+		return "rejected: " + o.err.Error()
+	}
+	return fmt.Sprintf("accepted: %+v", *o.uri)
+}
+
+// disagreement describes backends results for the corpus case when
+// results are not the same.
+func disagreement(input string, results map[string]outcome) string {
+	names := make([]string, 0, len(results))
+	for name := range results {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var b strings.Builder
+	fmt.Fprintf(&b, "backends disagree on %q:", input)
+	for _, name := range names {
+		fmt.Fprintf(&b, "\n\t%-7s %s", name, results[name])
+	}
+	return b.String()
+}
+
+func TestConformanceValid(t *testing.T) {
+	for _, tc := range validCorpus {
+		expect := URI{userinfo: tc.userinfo, hostport: tc.hostport, headers: tc.headers}
+		expect.setParams(tc.params)
+		expect.scheme = SIP
+		if strings.HasPrefix(tc.input, "sips:") {
+			expect.scheme = SIPS
+		}
+		results := make(map[string]outcome)
+		failed := false
+		for _, b := range backends {
+			uri, err := b.parse(tc.input)
+			results[b.name] = outcome{uri, err}
+			if err != nil || !reflect.DeepEqual(*uri, expect) {
+				failed = true
+			}
+		}
+		if failed {
+			t.Error(disagreement(tc.input, results))
+		}
+	}
+}
+
+func TestConformanceTel(t *testing.T) {
+	for _, tc := range telCorpus {
+		expect := URI{scheme: TEL, userinfo: tc.number}
+		expect.setParams(tc.params)
+		results := make(map[string]outcome)
+		failed, gaps := false, false
+		for _, b := range backends {
+			uri, err := b.parse(tc.input)
+			results[b.name] = outcome{uri, err}
+			if err == nil && reflect.DeepEqual(*uri, expect) {
+				continue
+			}
+			if b.supports&gTel != 0 {
+				failed = true
+			} else {
+				gaps = true
+			}
+		}
+		if failed {
+			t.Error(disagreement(tc.input, results))
+		} else if gaps {
+			t.Log(disagreement(tc.input, results))
+		}
+	}
+}
+
+// sameFailure reports whether backends supporting the whole grammar
+// reject input at the same offset in the same component.
+func sameFailure(results map[string]outcome) bool {
+	var first *ParseError
+	for _, b := range backends {
+		var perr *ParseError
+		if b.supports&gRFC3261 != gRFC3261 || !errors.As(results[b.name].err, &perr) {
+			continue
+		}
+		if first == nil {
+			first = perr
+		} else if perr.Offset != first.Offset || perr.Component != first.Component {
+			return false
+		}
+	}
+	return true
+}
+
+func TestConformanceInvalid(t *testing.T) {
+	for _, tc := range invalidCorpus {
+		results := make(map[string]outcome)
+		failed, gaps := false, false
+		for _, b := range backends {
+			uri, err := b.parse(tc.input)
+			results[b.name] = outcome{uri, err}
+			if err != nil {
+				continue
+			}
+			if tc.needs&^b.supports == 0 {
+				failed = true
+			} else {
+				gaps = true
+			}
+		}
+		if failed || !sameFailure(results) {
+			t.Error(disagreement(tc.input, results))
+		} else if gaps {
+			t.Log(disagreement(tc.input, results))
+		}
+	}
+}
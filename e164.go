@@ -0,0 +1,86 @@
+package uri
+
+import (
+	"errors"
+	"strings"
+)
+
+// E.164 number has at most 15 digits including country calling code.
+// Shorter numbers than e164MinDigits are assumed to be short codes.
+const (
+	e164MinDigits = 7
+	e164MaxDigits = 15
+)
+
+// ErrNotE164 is returned when telephone number can not be converted to
+// E.164 number.
+var ErrNotE164 = errors.New("not an E.164 number")
+
+// NormalizeE164 returns telephone number of the URI as E.164 number in
+// "+CCNSN" form with visual separators removed.
+//
+// Local number is prefixed with phone-context when it is global number
+// digits. Otherwise defaultCountry calling code like "44" or "+44" is
+// used and leading national trunk prefix "0" of the number is dropped.
+// User part of sip URI is treated as telephone number also without
+// user=phone parameter and when user=phone local number has no
+// phone-context.
+func (uri *URI) NormalizeE164(defaultCountry string) (string, error) {
+	var number, context string
+	t, err := uri.TelephoneSubscriber()
+	switch {
+	case err == nil:
+		number, context = t.Number, t.PhoneContext
+	case err == ErrNotTelephone, uri.scheme != TEL && isMissingContext(err):
+		user, _, _ := splitUserinfo(uri.userinfo)
+		number = unescape(user[:indexParamEnd(user, 0)])
+	default:
+		return "", err
+	}
+
+	if strings.HasPrefix(number, "+") {
+		if !isGlobalNumber(number) {
+			return "", ErrNotE164
+		}
+		return e164(telDigits(number[1:]))
+	}
+	if !isPhonedigits(number, false) {
+		return "", ErrNotE164
+	}
+	digits := telDigits(number)
+	if isGlobalNumber(context) {
+		return e164(telDigits(context[1:]) + digits)
+	}
+	cc := strings.TrimPrefix(defaultCountry, "+")
+	if !isCountryCode(cc) {
+		return "", ErrNotE164
+	}
+	return e164(cc + strings.TrimPrefix(digits, "0"))
+}
+
+// isMissingContext checks parseSubscriber error of local number without
+// phone-context, it is reported at the end of input.
+func isMissingContext(err error) bool {
+	var perr *ParseError
+	return errors.As(err, &perr) && perr.Err == ErrInvalidParams && perr.Offset == len(perr.Input)
+}
+
+// country calling code is 1 to 3 digits, the first one is not 0.
+func isCountryCode(s string) bool {
+	if s == "" || len(s) > 3 || s[0] == '0' {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if !isNum(s[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func e164(digits string) (string, error) {
+	if len(digits) < e164MinDigits || len(digits) > e164MaxDigits || digits[0] == '0' {
+		return "", ErrNotE164
+	}
+	return "+" + digits, nil
+}
@@ -0,0 +1,60 @@
+package uri
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeE164(t *testing.T) {
+	tests := []struct {
+		input, country, e164 string
+	}{
+		{"tel:+1-201-555-0123", "", "+12015550123"},
+		{"tel:+1-(201)-555.0123;ext=22", "44", "+12015550123"},
+		{"sip:+1-212-555-1212:1234@gateway.com;user=phone", "", "+12125551212"},
+		{"tel:863-1234;phone-context=+1-914-555", "", "+19145558631234"},
+		{"tel:020-7946-0018;phone-context=example.com", "44", "+442079460018"},
+		{"tel:020-7946-0018;phone-context=example.com", "+44", "+442079460018"},
+		{"sip:0207946-0018@gateway.com", "44", "+442079460018"},
+		{"sip:%2B44-20-7946-0018@gateway.com", "", "+442079460018"},
+		{"sips:2015550123;phone-context=example.com@gw;user=phone", "1", "+12015550123"},
+		{"sip:02012345678@host;user=phone", "44", "+442012345678"},
+		{"sip:020-1234-5678;ext=1@host;user=phone", "+44", "+442012345678"},
+	}
+
+	for _, tc := range tests {
+		uri, err := Parse(tc.input)
+		assert.Nil(t, err, tc.input)
+		e164, err := uri.NormalizeE164(tc.country)
+		assert.Nil(t, err, tc.input)
+		assert.Equal(t, tc.e164, e164, tc.input)
+	}
+}
+
+func TestNormalizeE164Fail(t *testing.T) {
+	tests := []struct {
+		input, country string
+		err            error
+	}{
+		{"sip:alice@atlanta.com", "1", ErrNotE164},
+		{"sip:atlanta.com", "1", ErrNotE164},
+		{"sip:+1-x@gateway.com", "1", ErrNotE164},
+		{"tel:*31%23;phone-context=example.com", "1", ErrNotE164},
+		{"tel:7042;phone-context=example.com", "", ErrNotE164},
+		{"tel:7042;phone-context=example.com", "1234", ErrNotE164},
+		{"tel:7042;phone-context=example.com", "044", ErrNotE164},
+		{"tel:112;phone-context=example.com", "49", ErrNotE164},
+		{"tel:+1-201-555-0123-4567-89", "", ErrNotE164},
+		{"tel:+0-201-555-0123", "", ErrNotE164},
+		{"sip:alice@atlanta.com;user=phone", "1", ErrInvalidNumber},
+	}
+
+	for _, tc := range tests {
+		uri, err := Parse(tc.input)
+		assert.Nil(t, err, tc.input)
+		e164, err := uri.NormalizeE164(tc.country)
+		assert.ErrorIs(t, err, tc.err, tc.input)
+		assert.Equal(t, "", e164, tc.input)
+	}
+}
@@ -0,0 +1,192 @@
+package uri
+
+import (
+	"errors"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// ENUMSuffix is the ENUM domain suffix of RFC6116.
+const ENUMSuffix = "e164.arpa"
+
+// ENUM errors.
+var (
+	ErrInvalidNAPTR = errors.New("invalid NAPTR regexp")
+	ErrNoNAPTRMatch = errors.New("no matching NAPTR record")
+)
+
+// ENUMDomain returns ENUM query domain of global telephone number
+// following RFC6116 #2.4. Visual separators are ignored, so
+// "+1-201-555-0123" is "3.2.1.0.5.5.5.1.0.2.1.e164.arpa".
+func ENUMDomain(number string) (string, error) {
+	if !isGlobalNumber(number) {
+		return "", ErrNotE164
+	}
+	number, err := e164(telDigits(number[1:]))
+	if err != nil {
+		return "", err
+	}
+	digits := number[1:]
+	buf := make([]byte, 0, 2*len(digits)+len(ENUMSuffix))
+	for i := len(digits) - 1; i >= 0; i-- {
+		buf = append(buf, digits[i], '.')
+	}
+	buf = append(buf, ENUMSuffix...)
+	return string(buf), nil
+}
+
+// ENUMDomain returns ENUM query domain of the URI telephone number.
+// Local numbers are normalized with NormalizeE164 and defaultCountry.
+func (uri *URI) ENUMDomain(defaultCountry string) (string, error) {
+	number, err := uri.NormalizeE164(defaultCountry)
+	if err != nil {
+		return "", err
+	}
+	return ENUMDomain(number)
+}
+
+// NAPTR is DNS NAPTR resource record (RFC3403 #4.1) of ENUM domain.
+type NAPTR struct {
+	Order       uint16
+	Preference  uint16
+	Flags       string // "u" for terminal rule resulting in URI
+	Services    string // ENUM services, e.g. "E2U+sip"
+	Regexp      string // substitution expression, e.g. "!^.*$!sip:info@example.com!"
+	Replacement string
+}
+
+// Rewrite applies NAPTR substitution expression to E.164 number in
+// "+CCNSN" form and parses the result as URI.
+func (n *NAPTR) Rewrite(number string) (*URI, error) {
+	result, err := substitute(n.Regexp, number)
+	if err != nil {
+		return nil, err
+	}
+	return strictParser.Parse(result)
+}
+
+// ENUMLookup rewrites E.164 number with the best terminal NAPTR record of
+// sip or tel ENUM service. Records are tried in order and preference
+// order, records that do not match the number or do not result in valid
+// URI are skipped.
+func ENUMLookup(number string, records []NAPTR) (*URI, error) {
+	sorted := make([]NAPTR, len(records))
+	copy(sorted, records)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Order != sorted[j].Order {
+			return sorted[i].Order < sorted[j].Order
+		}
+		return sorted[i].Preference < sorted[j].Preference
+	})
+	for i := range sorted {
+		n := &sorted[i]
+		if !strings.EqualFold(n.Flags, "u") || !isENUMService(n.Services) {
+			continue
+		}
+		if uri, err := n.Rewrite(number); err == nil {
+			return uri, nil
+		}
+	}
+	return nil, ErrNoNAPTRMatch
+}
+
+// enumservice = "E2U" 1*( "+" type [ ":" subtype ] ), e.g. "E2U+sip"
+// or "E2U+voice:tel". Only sip and tel URIs can be parsed.
+func isENUMService(services string) bool {
+	fields := strings.Split(strings.ToLower(services), "+")
+	if len(fields) < 2 || fields[0] != "e2u" {
+		return false
+	}
+	for _, service := range fields[1:] {
+		if service == "sip" || strings.HasSuffix(service, ":sip") || strings.HasSuffix(service, ":tel") {
+			return true
+		}
+	}
+	return false
+}
+
+// RFC3402 #3.2 Substitution Expression Grammar
+// subst-expr   = delim-char  ere  delim-char  repl  delim-char  *flags
+// delim-char   = "/" / "!" / <Any non-digit or non-flag character other than backslash '\'>
+// repl         = *(string / backref)
+// backref      = "\" POS-DIGIT
+// flags        = "i"
+//
+// substitute applies expression to s in the sed style, only the matched
+// part of s is replaced.
+func substitute(expr, s string) (string, error) {
+	if len(expr) < 3 {
+		return "", ErrInvalidNAPTR
+	}
+	delim := expr[0]
+	if isNum(delim) || delim == '\\' || delim == 'i' {
+		return "", ErrInvalidNAPTR
+	}
+	parts := splitUnescaped(expr[1:], delim)
+	if len(parts) != 3 || parts[2] != "" && parts[2] != "i" {
+		return "", ErrInvalidNAPTR
+	}
+	ere := parts[0]
+	if parts[2] == "i" {
+		ere = "(?i)" + ere
+	}
+	re, err := regexp.Compile(ere)
+	if err != nil {
+		return "", ErrInvalidNAPTR
+	}
+	match := re.FindStringSubmatchIndex(s)
+	if match == nil {
+		return "", ErrNoNAPTRMatch
+	}
+
+	repl := parts[1]
+	buf := make([]byte, 0, len(s)+len(repl))
+	buf = append(buf, s[:match[0]]...)
+	for i := 0; i < len(repl); i++ {
+		c := repl[i]
+		if c != '\\' || i+1 == len(repl) {
+			buf = append(buf, c)
+			continue
+		}
+		i++
+		c = repl[i]
+		if '1' <= c && c <= '9' {
+			n := int(c-'0') * 2
+			if n+1 >= len(match) {
+				return "", ErrInvalidNAPTR
+			}
+			if match[n] >= 0 {
+				buf = append(buf, s[match[n]:match[n+1]]...)
+			}
+			continue
+		}
+		buf = append(buf, c)
+	}
+	buf = append(buf, s[match[1]:]...)
+	return string(buf), nil
+}
+
+// splitUnescaped splits s by delim that is not escaped with "\".
+// Escaped delim is unescaped, other escapes are kept.
+func splitUnescaped(s string, delim byte) []string {
+	var parts []string
+	var buf []byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '\\' && i+1 < len(s) && s[i+1] == delim:
+			buf = append(buf, delim)
+			i++
+		case c == '\\' && i+1 < len(s):
+			buf = append(buf, c, s[i+1])
+			i++
+		case c == delim:
+			parts = append(parts, string(buf))
+			buf = buf[:0]
+		default:
+			buf = append(buf, c)
+		}
+	}
+	return append(parts, string(buf))
+}
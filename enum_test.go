@@ -0,0 +1,102 @@
+package uri
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestENUMDomain(t *testing.T) {
+	domain, err := ENUMDomain("+1-201-555-0123")
+	assert.Nil(t, err)
+	assert.Equal(t, "3.2.1.0.5.5.5.1.0.2.1.e164.arpa", domain)
+
+	domain, err = ENUMDomain("+441632960083")
+	assert.Nil(t, err)
+	assert.Equal(t, "3.8.0.0.6.9.2.3.6.1.4.4.e164.arpa", domain)
+
+	for _, number := range []string{"", "12015550123", "+1-201-x", "+123"} {
+		_, err = ENUMDomain(number)
+		assert.ErrorIs(t, err, ErrNotE164, number)
+	}
+
+	uri, _ := Parse("sip:020-7946-0018@gateway.com")
+	domain, err = uri.ENUMDomain("44")
+	assert.Nil(t, err)
+	assert.Equal(t, "8.1.0.0.6.4.9.7.0.2.4.4.e164.arpa", domain)
+}
+
+func TestSubstitute(t *testing.T) {
+	tests := []struct {
+		expr, input, result string
+	}{
+		{"!^.*$!sip:info@example.com!", "+12015550123", "sip:info@example.com"},
+		{`!^\+1(.*)$!sip:\1@gw.example.com!`, "+12015550123", "sip:2015550123@gw.example.com"},
+		{`!^(\+44)(.*)$!tel:\1-\2!`, "+441632960083", "tel:+44-1632960083"},
+		{"/^\\+1/sip:0/", "+12015550123", "sip:02015550123"},
+		{`#^\+1(2)0#sip:\1\##`, "+12015550123", "sip:2#15550123"},
+		{`!^\+1(3)?(2)!\1\2\\!`, "+12015550123", `2\015550123`},
+		{"!^\\+1-X$!tel:+1!i", "+1-x", "tel:+1"},
+	}
+	for _, tc := range tests {
+		result, err := substitute(tc.expr, tc.input)
+		assert.Nil(t, err, tc.expr)
+		assert.Equal(t, tc.result, result, tc.expr)
+	}
+
+	fail := []struct {
+		expr string
+		err  error
+	}{
+		{"", ErrInvalidNAPTR},
+		{"!^.*$!sip:info@example.com", ErrInvalidNAPTR},
+		{"!^.*$!sip:info@example.com!g", ErrInvalidNAPTR},
+		{"1^.*$1sip:info@example.com1", ErrInvalidNAPTR},
+		{"!^(.*$!sip:info@example.com!", ErrInvalidNAPTR},
+		{`!^.*$!sip:\1@example.com!`, ErrInvalidNAPTR},
+		{"!^\\+44!sip:info@example.com!", ErrNoNAPTRMatch},
+	}
+	for _, tc := range fail {
+		_, err := substitute(tc.expr, "+12015550123")
+		assert.ErrorIs(t, err, tc.err, tc.expr)
+	}
+}
+
+func TestNAPTRRewrite(t *testing.T) {
+	n := NAPTR{Order: 10, Preference: 10, Flags: "u", Services: "E2U+sip", Regexp: "!^.*$!sip:info@example.com!"}
+	uri, err := n.Rewrite("+12015550123")
+	assert.Nil(t, err)
+	assert.Equal(t, "sip:info@example.com", uri.String())
+
+	n.Regexp = "!^.*$!mailto:info@example.com!"
+	_, err = n.Rewrite("+12015550123")
+	assert.ErrorIs(t, err, ErrInvalidScheme)
+}
+
+func TestENUMLookup(t *testing.T) {
+	records := []NAPTR{
+		{100, 10, "u", "E2U+email:mailto", "!^.*$!mailto:info@example.com!", "."},
+		{100, 20, "u", "E2U+sip", `!^\+1(.*)$!sip:\1@backup.example.com!`, "."},
+		{100, 10, "u", "E2U+sip", `!^\+1(.*)$!sip:\1@example.com!`, "."},
+		{50, 10, "u", "E2U+sip", `!^\+44(.*)$!sip:\1@example.co.uk!`, "."},
+		{50, 20, "", "E2U+sip", "!^.*$!sip:nonterminal@example.com!", "."},
+		{200, 10, "u", "E2U+voice:tel", "!^(.*)$!tel:\\1!", "."},
+	}
+
+	uri, err := ENUMLookup("+12015550123", records)
+	assert.Nil(t, err)
+	assert.Equal(t, "sip:2015550123@example.com", uri.String())
+
+	uri, err = ENUMLookup("+441632960083", records)
+	assert.Nil(t, err)
+	assert.Equal(t, "sip:1632960083@example.co.uk", uri.String())
+
+	uri, err = ENUMLookup("+33123456789", records)
+	assert.Nil(t, err)
+	assert.Equal(t, "tel:+33123456789", uri.String())
+
+	_, err = ENUMLookup("+33123456789", records[:3])
+	assert.ErrorIs(t, err, ErrNoNAPTRMatch)
+	_, err = ENUMLookup("+12015550123", nil)
+	assert.ErrorIs(t, err, ErrNoNAPTRMatch)
+}
@@ -0,0 +1,154 @@
+package uri
+
+import (
+	"net"
+	"strings"
+)
+
+// RFC3261 #19.1.4 parameters that must be present in both URIs
+// to be equivalent.
+var mandatoryParams = []string{"user", "ttl", "method", "maddr", "transport"}
+
+// Equal compares URIs following RFC3261 #19.1.4 URI Comparison rules.
+// Userinfo is compared case-sensitive, all other components are
+// case-insensitive. Escaped characters are compared after decoding.
+func (uri *URI) Equal(other *URI) bool {
+	if uri == nil || other == nil {
+		return uri == other
+	}
+	if uri.scheme != other.scheme {
+		return false
+	}
+	if uri.scheme == TEL {
+		return uri.equalTel(other)
+	}
+	if !uri.equalUserinfo(other) {
+		return false
+	}
+	h1, _ := splitHostport(uri.hostport)
+	h2, _ := splitHostport(other.hostport)
+	if !equalHost(h1, h2) {
+		return false
+	}
+	p1, ok1 := uri.Port()
+	p2, ok2 := other.Port()
+	if ok1 != ok2 || p1 != p2 {
+		return false
+	}
+	if !uri.equalParams(other) {
+		return false
+	}
+	return equalHeaders(uri.Headers(), other.Headers())
+}
+
+// Hosts are compared the way Normalize writes them: IPv6 references
+// and IPv4 addresses by value, hostnames case-insensitive without
+// trailing dot.
+func equalHost(a, b string) bool {
+	v6a, v6b := strings.HasPrefix(a, "["), strings.HasPrefix(b, "[")
+	if v6a != v6b {
+		return false
+	}
+	var ip1, ip2 net.IP
+	if v6a {
+		ip1, ip2 = ipv6(strings.Trim(a, "[]")), ipv6(strings.Trim(b, "[]"))
+	} else {
+		ip1, ip2 = ipv4(a), ipv4(b)
+	}
+	if ip1 != nil && ip2 != nil {
+		return ip1.Equal(ip2)
+	}
+	return strings.EqualFold(strings.TrimSuffix(a, "."), strings.TrimSuffix(b, "."))
+}
+
+func (uri *URI) equalUserinfo(other *URI) bool {
+	if (uri.userinfo == "") != (other.userinfo == "") {
+		return false
+	}
+	if uri.User() != other.User() {
+		return false
+	}
+	p1, ok1 := uri.Password()
+	p2, ok2 := other.Password()
+	return ok1 == ok2 && p1 == p2
+}
+
+// RFC3966 #4 URI Comparisons: numbers are compared without visual
+// separators, hex digits case-insensitive. All parameters must be
+// present in both URIs with the same value, order is not significant.
+func (uri *URI) equalTel(other *URI) bool {
+	if !strings.EqualFold(telDigits(uri.userinfo), telDigits(other.userinfo)) {
+		return false
+	}
+	n := 0
+	it := uri.iterParams()
+	for p, ok := it.next(); ok; p, ok = it.next() {
+		value, ok := other.param(unescape(p.name))
+		if !ok || !strings.EqualFold(unescape(p.value), value) {
+			return false
+		}
+		n++
+	}
+	it = other.iterParams()
+	for _, ok := it.next(); ok; _, ok = it.next() {
+		n--
+	}
+	return n == 0
+}
+
+// Parameters present in both URIs must match. user, ttl, method, maddr
+// and transport must match even if only one URI has them. Any other
+// parameter present in one URI only is ignored.
+func (uri *URI) equalParams(other *URI) bool {
+	it := uri.iterParams()
+	for p, ok := it.next(); ok; p, ok = it.next() {
+		name := unescape(p.name)
+		value, ok := other.param(name)
+		if !ok {
+			if isMandatoryParam(name) {
+				return false
+			}
+			continue
+		}
+		if !strings.EqualFold(unescape(p.value), value) {
+			return false
+		}
+	}
+	it = other.iterParams()
+	for q, ok := it.next(); ok; q, ok = it.next() {
+		name := unescape(q.name)
+		if _, ok := uri.param(name); !ok && isMandatoryParam(name) {
+			return false
+		}
+	}
+	return true
+}
+
+func isMandatoryParam(name string) bool {
+	for _, n := range mandatoryParams {
+		if strings.EqualFold(n, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// Header components are never ignored and compared as unordered set.
+func equalHeaders(a, b Headers) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	used := make([]bool, len(b))
+outer:
+	for _, h := range a {
+		for i, g := range b {
+			if used[i] || !strings.EqualFold(h.Name, g.Name) || !strings.EqualFold(h.Value, g.Value) {
+				continue
+			}
+			used[i] = true
+			continue outer
+		}
+		return false
+	}
+	return true
+}
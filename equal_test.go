@@ -0,0 +1,69 @@
+package uri
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestURIEqual(t *testing.T) {
+	// RFC3261 #19.1.4 examples
+	equal := [][2]string{
+		{"sip:%61lice@atlanta.com;transport=TCP", "sip:alice@AtLanTa.CoM;Transport=tcp"},
+		{"sip:carol@chicago.com", "sip:carol@chicago.com;newparam=5"},
+		{"sip:carol@chicago.com", "sip:carol@chicago.com;security=on"},
+		{"sip:carol@chicago.com;newparam=5", "sip:carol@chicago.com;security=on"},
+		{"sip:biloxi.com;transport=tcp;method=REGISTER?to=sip:bob%40biloxi.com",
+			"sip:biloxi.com;method=REGISTER;transport=tcp?to=sip:bob%40biloxi.com"},
+		{"sip:alice@atlanta.com?subject=project%20x&priority=urgent",
+			"sip:alice@atlanta.com?priority=urgent&subject=project%20x"},
+		{"sip:alice@atlanta.com;lr", "sip:alice@atlanta.com;LR"},
+		{"sip:a@[::1]", "sip:a@[0:0::1]"},
+		{"sip:a@[2001:DB8::1]:5060", "sip:a@[2001:db8:0:0:0:0:0:1]:5060"},
+		{"sip:a@[::ffff:192.0.2.1]", "sip:a@[::FFFF:C000:0201]"},
+		{"sip:a@192.0.2.1", "sip:a@192.000.002.001"},
+		{"sip:alice@atlanta.com", "sip:alice@ATLANTA.com."},
+	}
+	notEqual := [][2]string{
+		{"sip:ALICE@AtLanTa.CoM;Transport=udp", "sip:alice@AtLanTa.CoM;Transport=UDP"},
+		{"sip:bob@biloxi.com", "sip:bob@biloxi.com:5060"},
+		{"sip:bob@biloxi.com", "sip:bob@biloxi.com;transport=udp"},
+		{"sip:bob@biloxi.com", "sip:bob@biloxi.com:6000;transport=tcp"},
+		{"sip:carol@chicago.com", "sip:carol@chicago.com?Subject=next%20meeting"},
+		{"sip:bob@phone21.boxesbybob.com", "sip:bob@192.0.2.4"},
+		{"sip:alice@atlanta.com", "sips:alice@atlanta.com"},
+		{"sip:alice@atlanta.com", "sip:alice:secret@atlanta.com"},
+		{"sip:alice@atlanta.com", "sip:atlanta.com"},
+		{"sip:alice@atlanta.com;ttl=1", "sip:alice@atlanta.com"},
+		{"sip:alice@atlanta.com;foo=1", "sip:alice@atlanta.com;foo=2"},
+		{"sip:alice@atlanta.com?a=1&a=1", "sip:alice@atlanta.com?a=1&b=1"},
+		{"sip:a@[::1]", "sip:a@[::2]"},
+		{"sip:a@[::ffff:192.0.2.1]", "sip:a@192.0.2.1"},
+		{"sip:alice@atlanta.com", "sip:alice@atlanta.co"},
+	}
+
+	for _, tc := range equal {
+		a, err := Re2GoParse(tc[0])
+		assert.Nil(t, err, tc[0])
+		b, err := RagelParse(tc[1])
+		assert.Nil(t, err, tc[1])
+		assert.True(t, a.Equal(b), tc)
+		assert.True(t, b.Equal(a), tc)
+	}
+
+	for _, tc := range notEqual {
+		a, err := Re2GoParse(tc[0])
+		assert.Nil(t, err, tc[0])
+		b, err := RagelParse(tc[1])
+		assert.Nil(t, err, tc[1])
+		assert.False(t, a.Equal(b), tc)
+		assert.False(t, b.Equal(a), tc)
+	}
+}
+
+func TestURIEqualNil(t *testing.T) {
+	var a, b *URI
+	assert.True(t, a.Equal(b))
+	assert.False(t, a.Equal(&URI{}))
+	assert.False(t, (&URI{}).Equal(b))
+}
@@ -0,0 +1,191 @@
+package uri
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Component of URI.
+type Component uint8
+
+// URI components
+const (
+	ComponentScheme Component = iota
+	ComponentUserinfo
+	ComponentHostport
+	ComponentParams
+	ComponentHeaders
+	ComponentNumber
+	ComponentNameAddr
+	ComponentHeaderParams
+)
+
+// String returns component name.
+func (c Component) String() string {
+	switch c {
+	case ComponentScheme:
+		return "scheme"
+	case ComponentUserinfo:
+		return "userinfo"
+	case ComponentHostport:
+		return "hostport"
+	case ComponentParams:
+		return "params"
+	case ComponentHeaders:
+		return "headers"
+	case ComponentNumber:
+		return "number"
+	case ComponentNameAddr:
+		return "name-addr"
+	case ComponentHeaderParams:
+		return "header params"
+	}
+	return "unknown"
+}
+
+// Reasons of URI parse failures. ParseError wraps one of them.
+var (
+	ErrInvalidScheme   = errors.New("invalid scheme")
+	ErrInvalidUserinfo = errors.New("invalid userinfo")
+	ErrInvalidHostport = errors.New("invalid host or port")
+	ErrInvalidPort     = errors.New("invalid port")
+	ErrInvalidParams   = errors.New("invalid params")
+	ErrInvalidHeaders  = errors.New("invalid headers")
+	ErrInvalidNumber   = errors.New("invalid telephone number")
+
+	ErrInvalidNameAddr     = errors.New("invalid name-addr")
+	ErrInvalidHeaderParams = errors.New("invalid header params")
+)
+
+// ParseError describes URI parse failure.
+type ParseError struct {
+	Input     string    // input string
+	Offset    int       // byte offset where parsing failed
+	Component Component // component being parsed
+	Err       error     // reason, one of ErrInvalid* errors
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("Invalid URI '%s': %s at offset %d", e.Input, e.Err, e.Offset)
+}
+
+// Unwrap returns failure reason.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// ValidationError reports URI parameter that is syntactically valid
+// but its value is not allowed, e.g. ttl=999.
+type ValidationError struct {
+	Param string // parameter name
+	Value string // unescaped parameter value
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("Invalid URI parameter %s value '%s'", e.Param, e.Value)
+}
+
+func newParseError(input string, offset int, reason error) *ParseError {
+	var c Component
+	switch reason {
+	case ErrInvalidScheme:
+		c = ComponentScheme
+	case ErrInvalidUserinfo:
+		c = ComponentUserinfo
+	case ErrInvalidHostport, ErrInvalidPort:
+		c = ComponentHostport
+	case ErrInvalidParams:
+		c = ComponentParams
+	case ErrInvalidHeaders:
+		c = ComponentHeaders
+	case ErrInvalidNumber:
+		c = ComponentNumber
+	case ErrInvalidNameAddr:
+		c = ComponentNameAddr
+	case ErrInvalidHeaderParams:
+		c = ComponentHeaderParams
+	}
+	return &ParseError{Input: input, Offset: offset, Component: c, Err: reason}
+}
+
+// errorAt returns ParseError for parsers that know only failure offset.
+// Component is detected by the URI delimiters found before offset.
+func errorAt(input string, offset int) *ParseError {
+	reasons := [...]error{
+		ComponentScheme:   ErrInvalidScheme,
+		ComponentUserinfo: ErrInvalidUserinfo,
+		ComponentHostport: ErrInvalidHostport,
+		ComponentParams:   ErrInvalidParams,
+		ComponentHeaders:  ErrInvalidHeaders,
+		ComponentNumber:   ErrInvalidNumber,
+	}
+	c := componentAt(input, offset)
+	if c == ComponentHostport && isPortAt(input, offset) {
+		return newParseError(input, offset, ErrInvalidPort)
+	}
+	return newParseError(input, offset, reasons[c])
+}
+
+// componentAt detects URI component at offset.
+// sip:user@host:port;params?headers
+// tel:number;params
+// Parsers stop at the first octet that can not continue valid URI, so
+// failure at delimiter is in the component before it. "@" is allowed
+// in sip URI only after userinfo. Failure after valid telephone number
+// is in params, e.g. missing phone-context.
+func componentAt(input string, offset int) Component {
+	pos := strings.IndexByte(input, ':')
+	if pos == -1 || offset <= pos {
+		return ComponentScheme
+	}
+	pos++
+	if hasTelScheme(input) {
+		number := input[pos:indexParamEnd(input, pos)]
+		if offset <= pos+len(number) && !isGlobalNumber(number) && !isLocalNumber(number) {
+			return ComponentNumber
+		}
+		return ComponentParams
+	}
+	if at := strings.IndexByte(input[pos:], '@'); at >= 0 {
+		if offset <= pos+at {
+			return ComponentUserinfo
+		}
+		pos += at + 1
+	}
+	pos += indexAnyOrEnd(input[pos:], ";?")
+	if offset <= pos {
+		return ComponentHostport
+	}
+	if input[pos] == ';' {
+		if offset <= pos+indexAnyOrEnd(input[pos:], "?") {
+			return ComponentParams
+		}
+	}
+	return ComponentHeaders
+}
+
+// isPortAt checks that offset in hostport of sip or sips URI is after
+// ":" of the port.
+func isPortAt(input string, offset int) bool {
+	pos := strings.IndexByte(input, ':') + 1
+	if at := strings.IndexByte(input[pos:], '@'); at >= 0 {
+		pos += at + 1
+	}
+	hostport := input[pos : pos+indexAnyOrEnd(input[pos:], ";?")]
+	colon := strings.IndexByte(hostport, ':')
+	if strings.HasPrefix(hostport, "[") {
+		if colon = strings.IndexByte(hostport, ']') + 1; colon == 0 || !strings.HasPrefix(hostport[colon:], ":") {
+			return false
+		}
+	}
+	return colon >= 0 && offset > pos+colon
+}
+
+// indexAnyOrEnd returns index of the first of chars in s or length of s.
+func indexAnyOrEnd(s, chars string) int {
+	if idx := strings.IndexAny(s, chars); idx >= 0 {
+		return idx
+	}
+	return len(s)
+}
@@ -0,0 +1,102 @@
+package uri
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseError(t *testing.T) {
+	tests := []struct {
+		input     string
+		offset    int
+		component Component
+		reason    error
+	}{
+		{"", 0, ComponentScheme, ErrInvalidScheme},
+		{"foo", 0, ComponentScheme, ErrInvalidScheme},
+		{"sip:", 4, ComponentHostport, ErrInvalidHostport},
+		{"sip:;foo?bar", 4, ComponentHostport, ErrInvalidHostport},
+		{"sip:?foo", 4, ComponentHostport, ErrInvalidHostport},
+		{"sip:atlanta.com;foo\"", 19, ComponentParams, ErrInvalidParams},
+		{"sip:alice@8.8.8.256", 19, ComponentHostport, ErrInvalidHostport},
+		{"sip:alice:pw@999.1.1.1;lr", 22, ComponentHostport, ErrInvalidHostport},
+		{"sip:alice@[1::2::3]:5060", 16, ComponentHostport, ErrInvalidHostport},
+		{"sip:alice@atlanta.com:65536", 22, ComponentHostport, ErrInvalidPort},
+		{"sips:10.0.0.1:1234567?a=b", 14, ComponentHostport, ErrInvalidPort},
+		{"sip:alice@atlanta.com:123456;lr", 22, ComponentHostport, ErrInvalidPort},
+		{"sip:atlanta.com:0065536", 16, ComponentHostport, ErrInvalidPort},
+		{"tel:", 4, ComponentNumber, ErrInvalidNumber},
+		{"TEL:1234", 8, ComponentParams, ErrInvalidParams},
+		{"sip:host;;lr", 9, ComponentParams, ErrInvalidParams},
+		{"sip:+1;postd=#@gw.com;user=phone", 13, ComponentUserinfo, ErrInvalidUserinfo},
+		{"tel:+1234;phone-context=+1", 23, ComponentParams, ErrInvalidParams},
+		{"tel:+1234;ext=1;ext=2", 19, ComponentParams, ErrInvalidParams},
+		{"tel:+1;isub=a#", 13, ComponentParams, ErrInvalidParams},
+	}
+
+	for _, name := range []string{"ragel", "re2go", "lexer"} {
+		parser, _ := Lookup(name)
+		for _, tc := range tests {
+			msg := fmt.Sprintf("%s: %q", name, tc.input)
+			_, err := parser.Parse(tc.input)
+
+			var perr *ParseError
+			assert.True(t, errors.As(err, &perr), msg)
+			assert.True(t, errors.Is(err, tc.reason), msg)
+			assert.Equal(t, tc.input, perr.Input, msg)
+			assert.Equal(t, tc.offset, perr.Offset, msg)
+			assert.Equal(t, tc.component, perr.Component, msg)
+		}
+	}
+}
+
+func TestParseErrorMessage(t *testing.T) {
+	_, err := LexerParse("sip:alice@atlanta.com:99999")
+	assert.True(t, errors.Is(err, ErrInvalidPort))
+	assert.Equal(t, "Invalid URI 'sip:alice@atlanta.com:99999': invalid port at offset 22", err.Error())
+	assert.Equal(t, ComponentHostport, err.(*ParseError).Component)
+}
+
+func TestComponentAt(t *testing.T) {
+	tests := []struct {
+		input     string
+		offset    int
+		component Component
+	}{
+		{"sips", 4, ComponentScheme},
+		{"sip:alice@atlanta.com", 3, ComponentScheme},
+		{"sip:alice@atlanta.com", 6, ComponentUserinfo},
+		{"sip:alice@atlanta.com", 9, ComponentUserinfo},
+		{"sip:alice@atlanta.com", 10, ComponentHostport},
+		{"sip:alice@", 10, ComponentHostport},
+		{"sip:alice@;lr", 10, ComponentHostport},
+		{"sip:alice@;lr", 12, ComponentParams},
+		{"sip:alice@[1::2::3]:5060", 16, ComponentHostport},
+		{"sip:alice@[::1]:x", 16, ComponentHostport},
+		{"sip:atlanta.com;lr?a=b", 15, ComponentHostport},
+		{"sip:atlanta.com;lr?a=b", 17, ComponentParams},
+		{"sip:atlanta.com;lr?a=b", 18, ComponentParams},
+		{"sip:atlanta.com;lr?a=b", 19, ComponentHeaders},
+		{"sip:atlanta.com?a=b", 17, ComponentHeaders},
+		{"tel:+1 201", 6, ComponentNumber},
+		{"tel:+1-201", 10, ComponentParams},
+		{"tel:+1;ext=a", 11, ComponentParams},
+	}
+
+	for _, tc := range tests {
+		assert.Equal(t, tc.component, componentAt(tc.input, tc.offset), tc.input)
+	}
+}
+
+func TestLaxParsersError(t *testing.T) {
+	for _, parse := range []func(string) (*URI, error){DummyParser, RegexParse} {
+		_, err := parse("foo:bar")
+		assert.True(t, errors.Is(err, ErrInvalidScheme))
+	}
+	_, err := RegexParse("sip:;lr")
+	assert.True(t, errors.Is(err, ErrInvalidHostport))
+	assert.Equal(t, 4, err.(*ParseError).Offset)
+}
@@ -0,0 +1,223 @@
+package uri
+
+import (
+	"fmt"
+	"strings"
+)
+
+const upperhex = "0123456789ABCDEF"
+
+// escaped = "%" HEXDIG HEXDIG
+// unescape decodes escaped octets. Malformed sequences are kept as is.
+func unescape(s string) string {
+	if strings.IndexByte(s, '%') == -1 {
+		return s
+	}
+	buf := make([]byte, 0, len(s))
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == '%' && i+2 < len(s) && isHex(s[i+1]) && isHex(s[i+2]) {
+			buf = append(buf, unhex(s[i+1])<<4|unhex(s[i+2]))
+			i += 2
+			continue
+		}
+		buf = append(buf, c)
+	}
+	return string(buf)
+}
+
+func unhex(c byte) byte {
+	switch {
+	case '0' <= c && c <= '9':
+		return c - '0'
+	case 'a' <= c && c <= 'f':
+		return c - 'a' + 10
+	case 'A' <= c && c <= 'F':
+		return c - 'A' + 10
+	}
+	return 0
+}
+
+// user-unreserved  =  "&" / "=" / "+" / "$" / "," / ";" / "?" / "/"
+func isUserChar(c byte) bool {
+	if isUnreserved(c) {
+		return true
+	}
+	switch c {
+	case '&', '=', '+', '$', ',', ';', '?', '/':
+		return true
+	}
+	return false
+}
+
+// password = *( unreserved / escaped / "&" / "=" / "+" / "$" / "," )
+func isPasswordChar(c byte) bool {
+	if isUnreserved(c) {
+		return true
+	}
+	switch c {
+	case '&', '=', '+', '$', ',':
+		return true
+	}
+	return false
+}
+
+// paramchar = param-unreserved / unreserved / escaped
+// param-unreserved = "[" / "]" / "/" / ":" / "&" / "+" / "$"
+func isParamChar(c byte) bool {
+	if isUnreserved(c) {
+		return true
+	}
+	switch c {
+	case '[', ']', '/', ':', '&', '+', '$':
+		return true
+	}
+	return false
+}
+
+// hnv-unreserved = "[" / "]" / "/" / "?" / ":" / "+" / "$"
+func isHeaderChar(c byte) bool {
+	if isUnreserved(c) {
+		return true
+	}
+	switch c {
+	case '[', ']', '/', '?', ':', '+', '$':
+		return true
+	}
+	return false
+}
+
+// isEscaped checks that s contains only allowed octets and valid escaped
+// sequences.
+func isEscaped(s string, allowed func(byte) bool) bool {
+	return indexInvalid(s, allowed) == -1
+}
+
+// indexInvalid returns index of the first octet that is not allowed and
+// does not start valid escaped sequence, or -1.
+func indexInvalid(s string, allowed func(byte) bool) int {
+	for i := 0; i < len(s); i++ {
+		if allowed(s[i]) {
+			continue
+		}
+		if s[i] != '%' || i+2 >= len(s) || !isHex(s[i+1]) || !isHex(s[i+2]) {
+			return i
+		}
+		i += 2
+	}
+	return -1
+}
+
+// scanEscaped returns end of allowed octets and escaped sequences in s
+// starting at pos. Malformed escaped sequence stops the scan with false
+// at the first unexpected octet.
+func scanEscaped(s string, pos int, allowed func(byte) bool) (int, bool) {
+	for pos < len(s) {
+		if allowed(s[pos]) {
+			pos++
+			continue
+		}
+		if s[pos] != '%' {
+			break
+		}
+		for i := 1; i < 3; i++ {
+			if pos+i >= len(s) || !isHex(s[pos+i]) {
+				return pos + i, false
+			}
+		}
+		pos += 3
+	}
+	return pos, true
+}
+
+// appendEscaped appends s to buf escaping every octet that is not allowed.
+func appendEscaped(buf []byte, s string, allowed func(byte) bool) []byte {
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; allowed(c) {
+			buf = append(buf, c)
+		} else {
+			buf = append(buf, '%', upperhex[c>>4], upperhex[c&0xF])
+		}
+	}
+	return buf
+}
+
+// appendRawEscaped appends already escaped s to buf escaping every
+// octet that is not allowed. Valid escaped sequences in s are kept as is.
+func appendRawEscaped(buf []byte, s string, allowed func(byte) bool) []byte {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if allowed(c) {
+			buf = append(buf, c)
+			continue
+		}
+		if c == '%' && i+2 < len(s) && isHex(s[i+1]) && isHex(s[i+2]) {
+			buf = append(buf, s[i:i+3]...)
+			i += 2
+			continue
+		}
+		buf = append(buf, '%', upperhex[c>>4], upperhex[c&0xF])
+	}
+	return buf
+}
+
+// EscapeError reports malformed escaped sequence or octet that is not
+// allowed in URI component.
+type EscapeError struct {
+	Value  string // escaped component
+	Offset int    // offset of invalid octet
+}
+
+func (e *EscapeError) Error() string {
+	return fmt.Sprintf("Invalid URI escape '%s' at offset %d", e.Value, e.Offset)
+}
+
+// EscapeUser escapes s to be used as user part of userinfo.
+func EscapeUser(s string) string {
+	return string(appendEscaped(nil, s, isUserChar))
+}
+
+// EscapePassword escapes s to be used as password part of userinfo.
+func EscapePassword(s string) string {
+	return string(appendEscaped(nil, s, isPasswordChar))
+}
+
+// EscapeParam escapes s to be used as URI parameter name or value.
+// ";" and "=" are escaped.
+func EscapeParam(s string) string {
+	return string(appendEscaped(nil, s, isParamChar))
+}
+
+// EscapeHeader escapes s to be used as URI header name or value.
+// "&" and "=" are escaped.
+func EscapeHeader(s string) string {
+	return string(appendEscaped(nil, s, isHeaderChar))
+}
+
+// UnescapeUser decodes escaped user part of userinfo. EscapeError is
+// returned for malformed escaped sequence or not allowed octet.
+func UnescapeUser(s string) (string, error) {
+	return unescapeStrict(s, isUserChar)
+}
+
+// UnescapePassword decodes escaped password part of userinfo.
+func UnescapePassword(s string) (string, error) {
+	return unescapeStrict(s, isPasswordChar)
+}
+
+// UnescapeParam decodes escaped URI parameter name or value.
+func UnescapeParam(s string) (string, error) {
+	return unescapeStrict(s, isParamChar)
+}
+
+// UnescapeHeader decodes escaped URI header name or value.
+func UnescapeHeader(s string) (string, error) {
+	return unescapeStrict(s, isHeaderChar)
+}
+
+func unescapeStrict(s string, allowed func(byte) bool) (string, error) {
+	if i := indexInvalid(s, allowed); i >= 0 {
+		return "", &EscapeError{s, i}
+	}
+	return unescape(s), nil
+}
@@ -0,0 +1,70 @@
+package uri
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEscape(t *testing.T) {
+	tests := []struct {
+		input                         string
+		user, password, param, header string
+	}{
+		{"alice", "alice", "alice", "alice", "alice"},
+		{"a b", "a%20b", "a%20b", "a%20b", "a%20b"},
+		{"a;b", "a;b", "a%3Bb", "a%3Bb", "a%3Bb"},
+		{"a?b", "a?b", "a%3Fb", "a%3Fb", "a?b"},
+		{"a&b=c", "a&b=c", "a&b=c", "a&b%3Dc", "a%26b%3Dc"},
+		{"a:b@c", "a%3Ab%40c", "a%3Ab%40c", "a:b%40c", "a:b%40c"},
+		{"[::1]/+$,", "%5B%3A%3A1%5D/+$,", "%5B%3A%3A1%5D%2F+$,", "[::1]/+$%2C", "[::1]/+$%2C"},
+		{"100%", "100%25", "100%25", "100%25", "100%25"},
+		{"\"<é>\"", "%22%3C%C3%A9%3E%22", "%22%3C%C3%A9%3E%22", "%22%3C%C3%A9%3E%22", "%22%3C%C3%A9%3E%22"},
+	}
+
+	for _, tc := range tests {
+		assert.Equal(t, tc.user, EscapeUser(tc.input), tc.input)
+		assert.Equal(t, tc.password, EscapePassword(tc.input), tc.input)
+		assert.Equal(t, tc.param, EscapeParam(tc.input), tc.input)
+		assert.Equal(t, tc.header, EscapeHeader(tc.input), tc.input)
+
+		for _, unescape := range []struct {
+			fn      func(string) (string, error)
+			escaped string
+		}{
+			{UnescapeUser, tc.user},
+			{UnescapePassword, tc.password},
+			{UnescapeParam, tc.param},
+			{UnescapeHeader, tc.header},
+		} {
+			s, err := unescape.fn(unescape.escaped)
+			assert.Nil(t, err, unescape.escaped)
+			assert.Equal(t, tc.input, s, unescape.escaped)
+		}
+	}
+}
+
+func TestUnescapeFail(t *testing.T) {
+	tests := []struct {
+		fn     func(string) (string, error)
+		input  string
+		offset int
+	}{
+		{UnescapeUser, "%", 0},
+		{UnescapeUser, "alice%2", 5},
+		{UnescapeUser, "alice%zz", 5},
+		{UnescapeUser, "alice@atlanta.com", 5},
+		{UnescapePassword, "a;b", 1},
+		{UnescapeParam, "a=b", 1},
+		{UnescapeParam, "a%4", 1},
+		{UnescapeHeader, "a&b", 1},
+		{UnescapeHeader, "a b", 1},
+	}
+
+	for _, tc := range tests {
+		s, err := tc.fn(tc.input)
+		assert.Equal(t, "", s, tc.input)
+		assert.Equal(t, &EscapeError{tc.input, tc.offset}, err, tc.input)
+	}
+	assert.Equal(t, "Invalid URI escape 'a%zz' at offset 1", (&EscapeError{"a%zz", 1}).Error())
+}
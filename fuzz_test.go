@@ -0,0 +1,94 @@
+//go:build go1.18
+// +build go1.18
+
+package uri
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func addCorpus(f *testing.F) {
+	for _, tc := range validCorpus {
+		f.Add(tc.input)
+	}
+	for _, tc := range invalidCorpus {
+		f.Add(tc.input)
+	}
+	for _, tc := range telCorpus {
+		f.Add(tc.input)
+	}
+}
+
+// fuzzParser checks that parser never panics or hangs, returns only
+// ParseError, agrees with other strict backends on result or failure
+// offset and component and that parse -> String -> parse is stable.
+func fuzzParser(t *testing.T, name, input string) {
+	parse := ParserFunc(nil)
+	for _, b := range backends {
+		if b.name == name {
+			parse = b.parse
+		}
+	}
+
+	uri, err := parse(input)
+	var perr *ParseError
+	if err != nil {
+		if !errors.As(err, &perr) {
+			t.Fatalf("%s(%q): unexpected error type %T: %v", name, input, err, err)
+		}
+		if perr.Offset < 0 || perr.Offset > len(input) {
+			t.Fatalf("%s(%q): offset %d out of input", name, input, perr.Offset)
+		}
+	}
+
+	for _, b := range backends {
+		if b.name == name || b.supports&gRFC3261 != gRFC3261 {
+			continue
+		}
+		other, oerr := b.parse(input)
+		if (err == nil) != (oerr == nil) {
+			t.Fatalf("%q: %s error %v, %s error %v", input, name, err, b.name, oerr)
+		}
+		if err == nil && !reflect.DeepEqual(uri, other) {
+			t.Fatalf("%q: %s result %+v, %s result %+v", input, name, *uri, b.name, *other)
+		}
+		var operr *ParseError
+		if errors.As(oerr, &operr) && (perr.Offset != operr.Offset || perr.Component != operr.Component) {
+			t.Fatalf("%q: %s error %v in %s, %s error %v in %s", input, name, err, perr.Component, b.name, oerr, operr.Component)
+		}
+	}
+
+	if err != nil {
+		return
+	}
+	again, err := parse(uri.String())
+	if err != nil {
+		t.Fatalf("%s(%q): can not parse String() %q: %v", name, input, uri.String(), err)
+	}
+	if !reflect.DeepEqual(again, uri) {
+		t.Fatalf("%s(%q): round trip %+v, expected %+v", name, input, *again, *uri)
+	}
+}
+
+func FuzzRagelParse(f *testing.F) {
+	addCorpus(f)
+	f.Fuzz(func(t *testing.T, input string) {
+		fuzzParser(t, "ragel", input)
+	})
+}
+
+func FuzzRe2GoParse(f *testing.F) {
+	addCorpus(f)
+	f.Fuzz(func(t *testing.T, input string) {
+		fuzzParser(t, "re2go", input)
+	})
+}
+
+func FuzzLexerParse(f *testing.F) {
+	addCorpus(f)
+	f.Fuzz(func(t *testing.T, input string) {
+		fuzzParser(t, "lexer", input)
+	})
+}
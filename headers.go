@@ -0,0 +1,175 @@
+package uri
+
+import "strings"
+
+// Header is URI header with unescaped name and value.
+type Header struct {
+	Name  string
+	Value string
+}
+
+// Headers is ordered list of URI headers. Same header name can appear
+// multiple times. Header names are matched case-insensitive.
+type Headers []Header
+
+// headers = "?" header *( "&" header )
+// header  = hname "=" hvalue
+// hname and hvalue can not contain "&" or "=" (only escaped).
+func parseHeaders(s string) Headers {
+	if s == "" {
+		return nil
+	}
+	headers := make(Headers, 0, strings.Count(s, "&")+1)
+	for s != "" {
+		var item string
+		if idx := strings.IndexByte(s, '&'); idx >= 0 {
+			item, s = s[:idx], s[idx+1:]
+		} else {
+			item, s = s, ""
+		}
+		name, value := item, ""
+		if idx := strings.IndexByte(item, '='); idx >= 0 {
+			name, value = item[:idx], item[idx+1:]
+		}
+		headers = append(headers, Header{unescape(name), unescape(value)})
+	}
+	return headers
+}
+
+// Get returns value of the first header with the name and true
+// if header exists.
+func (h Headers) Get(name string) (string, bool) {
+	for _, hdr := range h {
+		if strings.EqualFold(hdr.Name, name) {
+			return hdr.Value, true
+		}
+	}
+	return "", false
+}
+
+// Values returns values of all headers with the name in order.
+func (h Headers) Values(name string) []string {
+	var values []string
+	for _, hdr := range h {
+		if strings.EqualFold(hdr.Name, name) {
+			values = append(values, hdr.Value)
+		}
+	}
+	return values
+}
+
+// Add appends header to the list.
+func (h *Headers) Add(name, value string) {
+	*h = append(*h, Header{name, value})
+}
+
+// Set replaces all headers with the name by a single header.
+func (h *Headers) Set(name, value string) {
+	h.Del(name)
+	h.Add(name, value)
+}
+
+// Del removes all headers with the name.
+func (h *Headers) Del(name string) {
+	headers := (*h)[:0]
+	for _, hdr := range *h {
+		if !strings.EqualFold(hdr.Name, name) {
+			headers = append(headers, hdr)
+		}
+	}
+	*h = headers
+}
+
+// String returns escaped headers list without leading "?".
+func (h Headers) String() string {
+	return string(h.appendTo(nil))
+}
+
+func (h Headers) appendTo(buf []byte) []byte {
+	for i, hdr := range h {
+		if i > 0 {
+			buf = append(buf, '&')
+		}
+		buf = appendEscaped(buf, hdr.Name, isHeaderChar)
+		buf = append(buf, '=')
+		buf = appendEscaped(buf, hdr.Value, isHeaderChar)
+	}
+	return buf
+}
+
+// RFC3261 #19.1.5 header fields that should not be honored when request
+// is formed from URI.
+var unsafeHeaders = map[string]bool{
+	"From":            true,
+	"Call-ID":         true,
+	"CSeq":            true,
+	"Via":             true,
+	"Record-Route":    true,
+	"Route":           true,
+	"Accept":          true,
+	"Accept-Encoding": true,
+	"Accept-Language": true,
+	"Allow":           true,
+	"Contact":         true,
+	"Organization":    true,
+	"Supported":       true,
+	"User-Agent":      true,
+}
+
+// RFC3261 #7.3.3 compact forms and names that are not simply capitalized.
+var canonicalNames = map[string]string{
+	"i":                "Call-ID",
+	"m":                "Contact",
+	"e":                "Content-Encoding",
+	"l":                "Content-Length",
+	"c":                "Content-Type",
+	"f":                "From",
+	"s":                "Subject",
+	"k":                "Supported",
+	"t":                "To",
+	"v":                "Via",
+	"call-id":          "Call-ID",
+	"cseq":             "CSeq",
+	"mime-version":     "MIME-Version",
+	"www-authenticate": "WWW-Authenticate",
+}
+
+// CanonicalHeaderName returns SIP header name in canonical form:
+// compact form is expanded and each word is capitalized.
+// For example "subject" and "s" are returned as "Subject".
+func CanonicalHeaderName(name string) string {
+	lower := strings.ToLower(name)
+	if canon, ok := canonicalNames[lower]; ok {
+		return canon
+	}
+	buf := []byte(lower)
+	upper := true
+	for i, c := range buf {
+		if upper && 'a' <= c && c <= 'z' {
+			buf[i] = c - 'a' + 'A'
+		}
+		upper = c == '-'
+	}
+	return string(buf)
+}
+
+// MessageHeaders converts URI headers to SIP message headers following
+// RFC3261 #19.1.5. Header names are canonicalized and the special "body"
+// header is returned separately. Dangerous headers like From, Call-ID,
+// Via or Route are dropped.
+func (h Headers) MessageHeaders() (Headers, string) {
+	var headers Headers
+	var body string
+	for _, hdr := range h {
+		if strings.EqualFold(hdr.Name, "body") {
+			body = hdr.Value
+			continue
+		}
+		name := CanonicalHeaderName(hdr.Name)
+		if unsafeHeaders[name] {
+			continue
+		}
+		headers = append(headers, Header{name, hdr.Value})
+	}
+	return headers, body
+}
@@ -0,0 +1,68 @@
+package uri
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseHeaders(t *testing.T) {
+	tests := []struct {
+		input   string
+		headers Headers
+	}{
+		{"", nil},
+		{"to=alice%40atlanta.com", Headers{{"to", "alice@atlanta.com"}}},
+		{"subject=project%20x&priority=urgent", Headers{{"subject", "project x"}, {"priority", "urgent"}}},
+		{"?=", Headers{{"?", ""}}},
+		{"body=", Headers{{"body", ""}}},
+		{"a=1&A=2&b=3", Headers{{"a", "1"}, {"A", "2"}, {"b", "3"}}},
+	}
+
+	for _, tc := range tests {
+		assert.Equal(t, tc.headers, parseHeaders(tc.input), tc.input)
+	}
+}
+
+func TestHeadersAccess(t *testing.T) {
+	h := parseHeaders("route=%3Csip:a%3E&Subject=hi&route=%3Csip:b%3E")
+
+	v, ok := h.Get("ROUTE")
+	assert.True(t, ok)
+	assert.Equal(t, "<sip:a>", v)
+	assert.Equal(t, []string{"<sip:a>", "<sip:b>"}, h.Values("route"))
+	assert.Nil(t, h.Values("to"))
+
+	h.Set("route", "<sip:c>")
+	h.Add("priority", "urgent")
+	assert.Equal(t, "Subject=hi&route=%3Csip:c%3E&priority=urgent", h.String())
+
+	h.Del("subject")
+	assert.Equal(t, "route=%3Csip:c%3E&priority=urgent", h.String())
+}
+
+func TestCanonicalHeaderName(t *testing.T) {
+	tests := [][2]string{
+		{"subject", "Subject"},
+		{"s", "Subject"},
+		{"PRIORITY", "Priority"},
+		{"call-id", "Call-ID"},
+		{"i", "Call-ID"},
+		{"cseq", "CSeq"},
+		{"x-custom-header", "X-Custom-Header"},
+		{"in-reply-to", "In-Reply-To"},
+	}
+	for _, tc := range tests {
+		assert.Equal(t, tc[1], CanonicalHeaderName(tc[0]))
+	}
+}
+
+func TestMessageHeaders(t *testing.T) {
+	uri, err := RagelParse("sip:alice@atlanta.com?subject=project%20x&priority=urgent" +
+		"&call-id=1234&From=sip:eve%40evil.com&route=%3Csip:evil.com%3E&body=hello%20world")
+	assert.Nil(t, err)
+
+	headers, body := uri.Headers().MessageHeaders()
+	assert.Equal(t, Headers{{"Subject", "project x"}, {"Priority", "urgent"}}, headers)
+	assert.Equal(t, "hello world", body)
+}
@@ -0,0 +1,370 @@
+package uri
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// NameAddr is address of From, To, Contact, Route and similar headers
+// with display name and header parameters.
+//
+//	"Alice Liddell" <sip:alice@atlanta.com>;tag=1928301774
+type NameAddr struct {
+	DisplayName string // unquoted display name
+	URI         *URI
+	Params      HeaderParams
+
+	wildcard bool
+}
+
+// ParseAddressList parses comma separated list of header values, e.g.
+// Contact, Route or Record-Route header. Commas inside quoted strings and
+// angle brackets do not separate values. The Contact "*" wildcard is
+// accepted only as the single value of the list.
+// Contact = ( STAR / (contact-param *(COMMA contact-param)))
+func ParseAddressList(s string) ([]NameAddr, error) {
+	if pos := skipLWS(s, 0); pos < len(s) && s[pos] == '*' && skipLWS(s, pos+1) == len(s) {
+		return []NameAddr{{wildcard: true}}, nil
+	}
+
+	var list []NameAddr
+	for pos := 0; ; pos++ { // pos++ skips ","
+		if next := skipLWS(s, pos); pos > 0 && (next == len(s) || s[next] == ',') {
+			return nil, newParseError(s, next, ErrInvalidNameAddr)
+		}
+		na, end, err := parseNameAddr(s, pos)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, *na)
+		if end == len(s) {
+			return list, nil
+		}
+		pos = end
+	}
+}
+
+// HeaderParams are generic-params of header value. Unlike URI Params
+// they are not escaped, quoted-string values keep quotes.
+type HeaderParams []Param
+
+// Get returns value of the first parameter with the name and true
+// if parameter exists.
+func (p HeaderParams) Get(name string) (string, bool) {
+	return Params(p).Get(name)
+}
+
+// Has returns true if parameter with the name exists.
+func (p HeaderParams) Has(name string) bool {
+	return Params(p).Has(name)
+}
+
+// Set replaces value of the first parameter with the name or appends
+// new parameter. Empty value sets parameter without value.
+func (p *HeaderParams) Set(name, value string) {
+	(*Params)(p).Set(name, value)
+}
+
+// Del removes all parameters with the name.
+func (p *HeaderParams) Del(name string) {
+	(*Params)(p).Del(name)
+}
+
+// String returns parameters list as written in header without leading ";".
+func (p HeaderParams) String() string {
+	return string(p.appendTo(nil))
+}
+
+func (p HeaderParams) appendTo(buf []byte) []byte {
+	for i, param := range p {
+		if i > 0 {
+			buf = append(buf, ';')
+		}
+		buf = append(buf, param.Name...)
+		if param.Value != "" {
+			buf = append(buf, '=')
+			buf = append(buf, param.Value...)
+		}
+	}
+	return buf
+}
+
+// IsWildcard returns true for Contact "*" wildcard. Wildcard has no URI.
+func (na *NameAddr) IsWildcard() bool {
+	return na.wildcard
+}
+
+// rfc3261 #25.1
+// from-spec     = ( name-addr / addr-spec ) *( SEMI from-param )
+// name-addr     = [ display-name ] LAQUOT addr-spec RAQUOT
+// addr-spec     = SIP-URI / SIPS-URI / absoluteURI
+// display-name  = *(token LWS)/ quoted-string
+// generic-param = token [ EQUAL gen-value ]
+// gen-value     = token / host / quoted-string
+//
+// URI of addr-spec without angle brackets ends at the first ";" and
+// following parameters are header parameters. Only sip, sips and tel
+// URIs are accepted.
+func ParseNameAddr(s string) (*NameAddr, error) {
+	na, pos, err := parseNameAddr(s, 0)
+	if err != nil {
+		return nil, err
+	}
+	if pos != len(s) {
+		return nil, newParseError(s, pos, ErrInvalidHeaderParams)
+	}
+	return na, nil
+}
+
+// parseNameAddr parses name-addr starting at pos. It stops at the end of
+// s or at "," that separates header values.
+func parseNameAddr(s string, pos int) (*NameAddr, int, error) {
+	na := &NameAddr{}
+	pos = skipLWS(s, pos)
+	start := pos
+
+	switch {
+	case pos < len(s) && s[pos] == '"':
+		name, end, ok := parseQuoted(s, pos)
+		if !ok {
+			return nil, 0, newParseError(s, pos, ErrInvalidNameAddr)
+		}
+		na.DisplayName = name
+		pos = skipLWS(s, end)
+		if pos == len(s) || s[pos] != '<' {
+			return nil, 0, newParseError(s, pos, ErrInvalidNameAddr)
+		}
+	default:
+		end := pos
+		for end < len(s) && (isTokenChar(s[end]) || isLWS(s[end])) {
+			end++
+		}
+		if end < len(s) && s[end] == '<' {
+			na.DisplayName = strings.TrimRight(s[pos:end], " \t\r\n")
+			pos = end
+		}
+	}
+
+	var err error
+	if pos < len(s) && s[pos] == '<' {
+		end := strings.IndexByte(s[pos:], '>')
+		if end == -1 {
+			return nil, 0, newParseError(s, len(s), ErrInvalidNameAddr)
+		}
+		na.URI, err = parseAddrSpec(s, pos+1, pos+end)
+		pos += end + 1
+	} else {
+		pos = start
+		end := pos + strings.IndexAny(s[pos:]+",", ";,")
+		na.URI, err = parseAddrSpec(s, pos, pos+len(strings.TrimRight(s[pos:end], " \t\r\n")))
+		pos = end
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+
+	na.Params, pos, err = parseHeaderParams(s, pos)
+	if err != nil {
+		return nil, 0, err
+	}
+	return na, pos, nil
+}
+
+// parseAddrSpec parses URI s[start:end]. ParseError of the URI is
+// reported with s as input.
+func parseAddrSpec(s string, start, end int) (*URI, error) {
+	uri, err := strictParser.Parse(s[start:end])
+	if err != nil {
+		var perr *ParseError
+		if errors.As(err, &perr) {
+			return nil, &ParseError{s, start + perr.Offset, perr.Component, perr.Err}
+		}
+		return nil, newParseError(s, start, err)
+	}
+	return uri, nil
+}
+
+// *( SEMI generic-param ) followed by LWS
+func parseHeaderParams(s string, pos int) (HeaderParams, int, error) {
+	var params HeaderParams
+	for pos = skipLWS(s, pos); pos < len(s) && s[pos] == ';'; pos = skipLWS(s, pos) {
+		start := pos
+		pos = skipLWS(s, pos+1)
+		end := pos
+		for end < len(s) && isTokenChar(s[end]) {
+			end++
+		}
+		if end == pos {
+			return nil, 0, newParseError(s, start, ErrInvalidHeaderParams)
+		}
+		param := Param{Name: s[pos:end]}
+		pos = skipLWS(s, end)
+		if pos < len(s) && s[pos] == '=' {
+			pos = skipLWS(s, pos+1)
+			end = pos
+			if pos < len(s) && s[pos] == '"' {
+				_, quoted, ok := parseQuoted(s, pos)
+				if !ok {
+					return nil, 0, newParseError(s, pos, ErrInvalidHeaderParams)
+				}
+				end = quoted
+			} else {
+				for end < len(s) && (isTokenChar(s[end]) || s[end] == ':' || s[end] == '[' || s[end] == ']') {
+					end++
+				}
+			}
+			if end == pos {
+				return nil, 0, newParseError(s, start, ErrInvalidHeaderParams)
+			}
+			param.Value = s[pos:end]
+			pos = end
+		}
+		params = append(params, param)
+	}
+	if pos < len(s) && s[pos] != ',' {
+		return nil, 0, newParseError(s, pos, ErrInvalidHeaderParams)
+	}
+	return params, pos, nil
+}
+
+// quoted-string = SWS DQUOTE *(qdtext / quoted-pair ) DQUOTE
+// quoted-pair   = "\" (%x00-09 / %x0B-0C / %x0E-7F)
+// parseQuoted returns unquoted string and position after closing quote.
+func parseQuoted(s string, pos int) (string, int, bool) {
+	var buf []byte
+	for i := pos + 1; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '"':
+			if buf == nil {
+				return s[pos+1 : i], i + 1, true
+			}
+			return string(buf), i + 1, true
+		case '\\':
+			if i+1 == len(s) || s[i+1] == '\r' || s[i+1] == '\n' || s[i+1] > 0x7F {
+				return "", 0, false
+			}
+			if buf == nil {
+				buf = append(make([]byte, 0, len(s)-pos), s[pos+1:i]...)
+			}
+			i++
+			buf = append(buf, s[i])
+		default:
+			if buf != nil {
+				buf = append(buf, c)
+			}
+		}
+	}
+	return "", 0, false
+}
+
+// appendQuoted appends s as quoted-string.
+func appendQuoted(buf []byte, s string) []byte {
+	buf = append(buf, '"')
+	for i := 0; i < len(s); i++ {
+		if s[i] == '"' || s[i] == '\\' {
+			buf = append(buf, '\\')
+		}
+		buf = append(buf, s[i])
+	}
+	return append(buf, '"')
+}
+
+// String returns name-addr with URI in angle brackets.
+func (na *NameAddr) String() string {
+	return string(na.appendTo(nil))
+}
+
+func (na *NameAddr) appendTo(buf []byte) []byte {
+	if na.wildcard {
+		return append(buf, '*')
+	}
+	if na.DisplayName != "" {
+		buf = appendQuoted(buf, na.DisplayName)
+		buf = append(buf, ' ')
+	}
+	buf = append(buf, '<')
+	if na.URI != nil {
+		buf = na.URI.AppendTo(buf)
+	}
+	buf = append(buf, '>')
+	if len(na.Params) > 0 {
+		buf = append(buf, ';')
+		buf = na.Params.appendTo(buf)
+	}
+	return buf
+}
+
+// Tag returns tag parameter of From or To header.
+func (na *NameAddr) Tag() string {
+	tag, _ := na.Params.Get("tag")
+	return tag
+}
+
+// Expires returns expires parameter of Contact header in seconds and
+// true if parameter is present and valid. Value above 2**32-1 is
+// returned as 2**32-1 following RFC3261 #10.2.1.
+// c-p-expires = "expires" EQUAL delta-seconds
+func (na *NameAddr) Expires() (uint32, bool) {
+	value, _ := na.Params.Get("expires")
+	n, err := strconv.ParseUint(value, 10, 32)
+	if errors.Is(err, strconv.ErrRange) {
+		return math.MaxUint32, true
+	}
+	return uint32(n), err == nil
+}
+
+// Q returns q parameter of Contact header and true if parameter is present
+// and valid.
+// qvalue = ( "0" [ "." 0*3DIGIT ] ) / ( "1" [ "." 0*3("0") ] )
+func (na *NameAddr) Q() (float64, bool) {
+	value, ok := na.Params.Get("q")
+	if !ok || !isQvalue(value) {
+		return 0, false
+	}
+	q, err := strconv.ParseFloat(value, 64)
+	return q, err == nil
+}
+
+func isQvalue(s string) bool {
+	if s == "" || s[0] != '0' && s[0] != '1' {
+		return false
+	}
+	if len(s) == 1 {
+		return true
+	}
+	if s[1] != '.' || len(s) > 5 {
+		return false
+	}
+	for i := 2; i < len(s); i++ {
+		if !isNum(s[i]) || s[0] == '1' && s[i] != '0' {
+			return false
+		}
+	}
+	return true
+}
+
+// token = 1*(alphanum / "-" / "." / "!" / "%" / "*" / "_" / "+" / "`" / "'" / "~" )
+func isTokenChar(c byte) bool {
+	if isAlphaNum(c) {
+		return true
+	}
+	switch c {
+	case '-', '.', '!', '%', '*', '_', '+', '`', '\'', '~':
+		return true
+	}
+	return false
+}
+
+// LWS = [*WSP CRLF] 1*WSP
+func isLWS(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r' || c == '\n'
+}
+
+func skipLWS(s string, pos int) int {
+	for pos < len(s) && isLWS(s[pos]) {
+		pos++
+	}
+	return pos
+}
@@ -0,0 +1,187 @@
+package uri
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseNameAddr(t *testing.T) {
+	tests := []struct {
+		input   string
+		display string
+		uri     string
+		params  HeaderParams
+	}{
+		{`"Alice Liddell" <sip:alice@atlanta.com>;tag=1928301774`, "Alice Liddell", "sip:alice@atlanta.com", HeaderParams{{"tag", "1928301774"}}},
+		{`Bob <sips:bob@biloxi.com;transport=tcp>`, "Bob", "sips:bob@biloxi.com;transport=tcp", nil},
+		{`Lee M. Foote <sip:lee.foote@example.com>`, "Lee M. Foote", "sip:lee.foote@example.com", nil},
+		{`<sip:carol@chicago.com?subject=hi>  ;  expires = 3600 ; q=0.7`, "", "sip:carol@chicago.com?subject=hi", HeaderParams{{"expires", "3600"}, {"q", "0.7"}}},
+		{`sip:+12125551212@phone2net.com;tag=887s`, "", "sip:+12125551212@phone2net.com", HeaderParams{{"tag", "887s"}}},
+		{`sip:alice@atlanta.com ;lr`, "", "sip:alice@atlanta.com", HeaderParams{{"lr", ""}}},
+		{`"\"Alice\" \\ A" <sip:alice@atlanta.com>`, `"Alice" \ A`, "sip:alice@atlanta.com", nil},
+		{`"" <tel:+1-201-555-0123>;received=[2001:db8::1];x="a;b"`, "", "tel:+1-201-555-0123", HeaderParams{{"received", "[2001:db8::1]"}, {"x", `"a;b"`}}},
+		{"\t\"Anonymous\"\t<sip:anonymous@anonymous.invalid>;tag=hyh8", "Anonymous", "sip:anonymous@anonymous.invalid", HeaderParams{{"tag", "hyh8"}}},
+	}
+
+	for _, tc := range tests {
+		na, err := ParseNameAddr(tc.input)
+		if !assert.Nil(t, err, tc.input) {
+			continue
+		}
+		assert.Equal(t, tc.display, na.DisplayName, tc.input)
+		assert.Equal(t, tc.uri, na.URI.String(), tc.input)
+		assert.Equal(t, tc.params, na.Params, tc.input)
+
+		again, err := ParseNameAddr(na.String())
+		assert.Nil(t, err, na.String())
+		assert.Equal(t, na, again, na.String())
+	}
+}
+
+func TestParseNameAddrFail(t *testing.T) {
+	tests := []struct {
+		input     string
+		offset    int
+		component Component
+		err       error
+	}{
+		{``, 0, ComponentScheme, ErrInvalidScheme},
+		{`"Alice <sip:alice@atlanta.com>`, 0, ComponentNameAddr, ErrInvalidNameAddr},
+		{`"Alice" sip:alice@atlanta.com`, 8, ComponentNameAddr, ErrInvalidNameAddr},
+		{`Alice <sip:alice@atlanta.com`, 28, ComponentNameAddr, ErrInvalidNameAddr},
+		{`Alice <sip:alice@>`, 17, ComponentHostport, ErrInvalidHostport},
+		{`Alice <http://atlanta.com>`, 7, ComponentScheme, ErrInvalidScheme},
+		{`<sip:alice@atlanta.com>;`, 23, ComponentHeaderParams, ErrInvalidHeaderParams},
+		{`<sip:alice@atlanta.com>;tag=`, 23, ComponentHeaderParams, ErrInvalidHeaderParams},
+		{`<sip:alice@atlanta.com>;x="a`, 26, ComponentHeaderParams, ErrInvalidHeaderParams},
+		{`<sip:alice@atlanta.com> foo`, 24, ComponentHeaderParams, ErrInvalidHeaderParams},
+		{`<sip:alice@atlanta.com>, <sip:bob@biloxi.com>`, 23, ComponentHeaderParams, ErrInvalidHeaderParams},
+	}
+
+	for _, tc := range tests {
+		na, err := ParseNameAddr(tc.input)
+		assert.Nil(t, na, tc.input)
+		assert.Equal(t, &ParseError{tc.input, tc.offset, tc.component, tc.err}, err, tc.input)
+	}
+}
+
+func TestNameAddrParams(t *testing.T) {
+	na, err := ParseNameAddr(`<sip:alice@pc33.atlanta.com>;Expires=3600;q=0.5;TAG=a6c85cf`)
+	assert.Nil(t, err)
+	assert.Equal(t, "a6c85cf", na.Tag())
+	expires, ok := na.Expires()
+	assert.True(t, ok)
+	assert.Equal(t, uint32(3600), expires)
+	q, ok := na.Q()
+	assert.True(t, ok)
+	assert.Equal(t, 0.5, q)
+
+	for _, value := range []string{"1", "1.000", "0", "0.", "0.123"} {
+		assert.True(t, isQvalue(value), value)
+	}
+	for _, value := range []string{"", "2", "1.1", "0.1234", ".5", "0,5", "01"} {
+		assert.False(t, isQvalue(value), value)
+	}
+
+	na, _ = ParseNameAddr(`<sip:alice@pc33.atlanta.com>;expires=soon;q=1.5`)
+	assert.Equal(t, "", na.Tag())
+	_, ok = na.Expires()
+	assert.False(t, ok)
+	_, ok = na.Q()
+	assert.False(t, ok)
+
+	na, _ = ParseNameAddr(`<sip:alice@atlanta.com>;tag="x,y";x=%41`)
+	assert.Equal(t, `tag="x,y";x=%41`, na.Params.String())
+	na.Params.Set("tag", "z")
+	na.Params.Del("X")
+	assert.True(t, na.Params.Has("TAG"))
+	assert.Equal(t, "<sip:alice@atlanta.com>;tag=z", na.String())
+
+	tests := []struct {
+		value   string
+		expires uint32
+		ok      bool
+	}{
+		{"0", 0, true},
+		{"5000000", 5000000, true},
+		{"4294967295", 4294967295, true},
+		{"4294967296", 4294967295, true},
+		{"99999999999999999999", 4294967295, true},
+		{"", 0, false},
+		{"+60", 0, false},
+		{"-1", 0, false},
+	}
+	for _, tc := range tests {
+		na := &NameAddr{Params: HeaderParams{{"expires", tc.value}}}
+		expires, ok := na.Expires()
+		assert.Equal(t, tc.ok, ok, tc.value)
+		assert.Equal(t, tc.expires, expires, tc.value)
+	}
+}
+
+func TestParseAddressList(t *testing.T) {
+	list, err := ParseAddressList(`"Smith, John" <sip:john@example.com;transport=tcp>;q=0.7, ` +
+		`sip:bob@biloxi.com;expires=60,<sip:carol,c@chicago.com?subject=a>,` +
+		"\r\n\t<sip:p1.example.com;lr>;x=\"a,b\"")
+	assert.Nil(t, err)
+	if assert.Len(t, list, 4) {
+		assert.Equal(t, "Smith, John", list[0].DisplayName)
+		assert.Equal(t, "sip:john@example.com;transport=tcp", list[0].URI.String())
+		assert.Equal(t, HeaderParams{{"q", "0.7"}}, list[0].Params)
+		assert.Equal(t, "sip:bob@biloxi.com", list[1].URI.String())
+		assert.Equal(t, HeaderParams{{"expires", "60"}}, list[1].Params)
+		assert.Equal(t, "sip:carol,c@chicago.com?subject=a", list[2].URI.String())
+		assert.Equal(t, "sip:p1.example.com;lr", list[3].URI.String())
+		assert.Equal(t, HeaderParams{{"x", `"a,b"`}}, list[3].Params)
+	}
+
+	list, err = ParseAddressList("<sip:p1.example.com;lr>,<sip:p2.example.com;lr>,<sip:p3.example.com;lr>")
+	assert.Nil(t, err)
+	var hosts []string
+	for _, na := range list {
+		hosts = append(hosts, na.URI.Host())
+		assert.False(t, na.IsWildcard())
+	}
+	assert.Equal(t, []string{"p1.example.com", "p2.example.com", "p3.example.com"}, hosts)
+
+	list, err = ParseAddressList(" * ")
+	assert.Nil(t, err)
+	if assert.Len(t, list, 1) {
+		assert.True(t, list[0].IsWildcard())
+		assert.Nil(t, list[0].URI)
+		assert.Equal(t, "*", list[0].String())
+	}
+
+	list, err = ParseAddressList("*Bob <sip:bob@x>, *  <sip:carol@x>")
+	assert.Nil(t, err)
+	if assert.Len(t, list, 2) {
+		assert.False(t, list[0].IsWildcard())
+		assert.Equal(t, "*Bob", list[0].DisplayName)
+		assert.Equal(t, "sip:bob@x", list[0].URI.String())
+		assert.Equal(t, "*", list[1].DisplayName)
+	}
+}
+
+func TestParseAddressListFail(t *testing.T) {
+	tests := []struct {
+		input     string
+		offset    int
+		component Component
+		err       error
+	}{
+		{"", 0, ComponentScheme, ErrInvalidScheme},
+		{"<sip:a@b.com>,", 14, ComponentNameAddr, ErrInvalidNameAddr},
+		{"<sip:a@b.com>, \t", 16, ComponentNameAddr, ErrInvalidNameAddr},
+		{"<sip:a@b.com>,,<sip:c@d.com>", 14, ComponentNameAddr, ErrInvalidNameAddr},
+		{"*, <sip:a@b.com>", 0, ComponentScheme, ErrInvalidScheme},
+		{"<sip:a@b.com>, *", 15, ComponentScheme, ErrInvalidScheme},
+		{"<sip:a@b.com> <sip:c@d.com>", 14, ComponentHeaderParams, ErrInvalidHeaderParams},
+	}
+
+	for _, tc := range tests {
+		list, err := ParseAddressList(tc.input)
+		assert.Nil(t, list, tc.input)
+		assert.Equal(t, &ParseError{tc.input, tc.offset, tc.component, tc.err}, err, tc.input)
+	}
+}
@@ -0,0 +1,109 @@
+package uri
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Normalize returns URI in canonical form, so URIs equal by Equal have
+// the same String unless only one of them has a parameter that Equal
+// ignores:
+//   - host and parameter and header names and values are lower case,
+//     trailing dot of hostname is removed
+//   - IPv6 reference is in RFC5952 form, IPv4 octets and port have no
+//     leading zeros
+//   - only octets not allowed in component are escaped, with upper case
+//     hex digits
+//   - parameters and headers are sorted by name and value
+//
+// Telephone number of tel URI is normalized with ToTel, parameter
+// values are lower case.
+func (uri *URI) Normalize() *URI {
+	if uri.scheme == TEL {
+		if tel, err := uri.ToTel(); err == nil {
+			tel.setParams(toLowerEscaped(tel.params))
+			return tel
+		}
+		n := *uri
+		return &n
+	}
+
+	n := &URI{scheme: uri.scheme}
+	if uri.userinfo != "" {
+		buf := appendEscaped(nil, uri.User(), isUserChar)
+		if passwd, ok := uri.Password(); ok {
+			buf = append(buf, ':')
+			buf = appendEscaped(buf, passwd, isPasswordChar)
+		}
+		n.userinfo = string(buf)
+	}
+	host, port := splitHostport(uri.hostport)
+	n.hostport = normalizeHost(host)
+	if port != "" {
+		if p, ok := uri.Port(); ok {
+			port = strconv.Itoa(p)
+		}
+		n.hostport += ":" + port
+	}
+	params := uri.Params()
+	items := make([]string, len(params))
+	for i, p := range params {
+		items[i] = Params{{strings.ToLower(p.Name), strings.ToLower(p.Value)}}.String()
+	}
+	n.setParams(sortedList(items, ";"))
+	headers := uri.Headers()
+	items = make([]string, len(headers))
+	for i, h := range headers {
+		items[i] = Headers{{strings.ToLower(h.Name), strings.ToLower(h.Value)}}.String()
+	}
+	n.headers = sortedList(items, "&")
+	return n
+}
+
+// normalizeHost returns lower case hostname without trailing dot,
+// IPv4 address without leading zeros or RFC5952 IPv6 reference.
+func normalizeHost(host string) string {
+	if strings.HasPrefix(host, "[") && strings.HasSuffix(host, "]") {
+		return "[" + normalizeIPv6(host[1:len(host)-1]) + "]"
+	}
+	if ip := ipv4(host); ip != nil {
+		return ip.String()
+	}
+	return strings.ToLower(strings.TrimSuffix(host, "."))
+}
+
+// RFC5952 #4 text representation: leading zeros are suppressed, the
+// longest run of zero groups is compressed with "::", hex digits are lower
+// case. IPv4-mapped address keeps dotted IPv4 tail (RFC5952 #5).
+func normalizeIPv6(s string) string {
+	ip := ipv6(s)
+	if ip == nil {
+		return strings.ToLower(s)
+	}
+	if v4 := ip.To4(); v4 != nil {
+		return "::ffff:" + v4.String()
+	}
+	return ip.String()
+}
+
+// sortedList sorts items as strings, so by name first, and joins them
+// with sep.
+func sortedList(items []string, sep string) string {
+	sort.Strings(items)
+	return strings.Join(items, sep)
+}
+
+// toLowerEscaped lower cases s keeping hex digits of escaped sequences.
+func toLowerEscaped(s string) string {
+	buf := []byte(s)
+	for i := 0; i < len(buf); i++ {
+		switch c := buf[i]; {
+		case c == '%':
+			i += 2
+		case 'A' <= c && c <= 'Z':
+			buf[i] = c - 'A' + 'a'
+		}
+	}
+	return string(buf)
+}
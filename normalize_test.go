@@ -0,0 +1,77 @@
+package uri
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		input, normalized string
+	}{
+		{"sip:alice@atlanta.com", "sip:alice@atlanta.com"},
+		{"sip:Alice@AtLanTa.CoM.", "sip:Alice@atlanta.com"},
+		{"sip:%61lice%3a%40@atlanta.com", "sip:alice%3A%40@atlanta.com"},
+		{"sip:alice:%73ecret@atlanta.com", "sip:alice:secret@atlanta.com"},
+		{"sips:alice@192.000.002.004:05061", "sips:alice@192.0.2.4:5061"},
+		{"sip:[2001:DB8:0:0:0:0:0:10]:5070", "sip:[2001:db8::10]:5070"},
+		{"sip:[2001:db8:0:0:1:0:0:1]", "sip:[2001:db8::1:0:0:1]"},
+		{"sip:[0:0:0:0:0:FFFF:192.0.2.1]", "sip:[::ffff:192.0.2.1]"},
+		{"sip:atlanta.com;Transport=TCP;LR;Maddr=Proxy.Atlanta.COM.;X=Y", "sip:atlanta.com;lr;maddr=proxy.atlanta.com.;transport=tcp;x=y"},
+		{"sip:atlanta.com;maddr=[FF02:0::1];method=INVITE", "sip:atlanta.com;maddr=[ff02:0::1];method=invite"},
+		{"sip:atlanta.com;%66oo=%62ar%3b", "sip:atlanta.com;foo=bar%3B"},
+		{"sip:atlanta.com?Subject=Hi&Priority=urgent&subject=a%2a", "sip:atlanta.com?priority=urgent&subject=a*&subject=hi"},
+		{"tel:+1-(201)-555-0123;ISUB=A1", "tel:+12015550123;isub=a1"},
+	}
+
+	for _, tc := range tests {
+		uri, err := Parse(tc.input)
+		if !assert.Nil(t, err, tc.input) {
+			continue
+		}
+		normalized := uri.Normalize()
+		assert.Equal(t, tc.normalized, normalized.String(), tc.input)
+		assert.Equal(t, normalized, normalized.Normalize(), tc.input)
+
+		parsed, err := Parse(normalized.String())
+		assert.Nil(t, err, tc.input)
+		assert.Equal(t, normalized, parsed, tc.input)
+	}
+}
+
+func TestNormalizeKeys(t *testing.T) {
+	equivalent := [][]string{
+		{"sip:alice@ATLANTA.com;transport=TCP", "sip:%61lice@atlanta.com.;Transport=tcp"},
+		{"sip:bob@biloxi.com;lr;transport=udp", "sip:bob@biloxi.com;transport=UDP;lr"},
+		{"sip:carol@[2001:db8::1]:5060", "sip:carol@[2001:0db8:0000::0001]:5060"},
+		{"sip:atlanta.com?to=bob&subject=hi", "sip:atlanta.com?subject=hi&to=bob"},
+		{"sip:a@b;foo=BAR?subject=Hi", "sip:a@b;foo=bar?subject=hi"},
+		{"sip:a%26b:%3a@b;x=%3a", "sip:a&b:%3A@b;x=:"},
+		{"tel:+1-201;Foo=BAR", "tel:+1201;foo=bar"},
+	}
+
+	for _, uris := range equivalent {
+		keys := make(map[string]bool)
+		first, _ := Parse(uris[0])
+		for _, input := range uris {
+			uri, err := Parse(input)
+			assert.Nil(t, err, input)
+			assert.True(t, first.Equal(uri), input)
+			keys[uri.Normalize().String()] = true
+		}
+		assert.Len(t, keys, 1, "%v", uris)
+	}
+}
+
+func TestNormalizeCorpus(t *testing.T) {
+	for _, tc := range validCorpus {
+		uri, _ := Parse(tc.input)
+		normalized := uri.Normalize()
+		assert.Equal(t, normalized, normalized.Normalize(), tc.input)
+		parsed, err := Parse(normalized.String())
+		if assert.Nil(t, err, tc.input) {
+			assert.Equal(t, normalized, parsed, tc.input)
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package uri
+
+import "strings"
+
+// Param is URI parameter. Value is empty for parameters without
+// value like "lr".
+type Param struct {
+	Name  string
+	Value string
+}
+
+// Params is ordered list of URI parameters.
+// Parameter names are matched case-insensitive.
+type Params []Param
+
+// paramSpan is name and value of a parameter as written in URI, both
+// are substrings of the parameters string and escaped.
+type paramSpan struct {
+	name  string
+	value string
+}
+
+// paramSpans are boundaries of the first parameters recorded by parser
+// as name and parameter end offsets in the parameters string. Offsets
+// fit in a byte so URI stays a single small allocation, parameters
+// not recorded are found by scanning.
+type paramSpans struct {
+	n    uint8
+	ends [3][2]uint8
+}
+
+// add records the next parameter, ignored when the spans are full or
+// the offsets do not fit.
+func (s *paramSpans) add(nameEnd, end int) {
+	if int(s.n) < len(s.ends) && nameEnd >= 0 && nameEnd <= end && end <= 0xFF {
+		s.ends[s.n] = [2]uint8{uint8(nameEnd), uint8(end)}
+		s.n++
+	}
+}
+
+// uri-parameters = *( ";" uri-parameter)
+// uri-parameter  = paramchar+ ( "=" paramchar+ )?
+// paramchar can not be ";" or "=" (only escaped) so parameters string
+// accepted by parser is split on those without extra validation.
+// Parsers record spans while matching parameters, scanParams is used
+// for parameters set without parser.
+func scanParams(s string) paramSpans {
+	var spans paramSpans
+	it := paramIter{s: s}
+	for _, ok := it.next(); ok; _, ok = it.next() {
+		spans.add(it.nameEnd, it.pos-1)
+	}
+	return spans
+}
+
+// paramIter iterates parameters string using recorded spans first.
+type paramIter struct {
+	s       string
+	spans   paramSpans
+	i       int
+	pos     int
+	nameEnd int
+}
+
+func (it *paramIter) next() (paramSpan, bool) {
+	start := it.pos
+	if start >= len(it.s) {
+		return paramSpan{}, false
+	}
+	end := len(it.s)
+	if it.i < int(it.spans.n) {
+		it.nameEnd, end = int(it.spans.ends[it.i][0]), int(it.spans.ends[it.i][1])
+		it.i++
+	} else {
+		if idx := strings.IndexByte(it.s[start:], ';'); idx >= 0 {
+			end = start + idx
+		}
+		it.nameEnd = end
+		if idx := strings.IndexByte(it.s[start:end], '='); idx >= 0 {
+			it.nameEnd = start + idx
+		}
+	}
+	it.pos = end + 1
+	if it.nameEnd < end {
+		return paramSpan{it.s[start:it.nameEnd], it.s[it.nameEnd+1 : end]}, true
+	}
+	return paramSpan{it.s[start:end], ""}, true
+}
+
+// parseParams returns unescaped parameters of parameters string.
+func parseParams(s string) Params {
+	return unescapeParams(strings.TrimPrefix(s, ";"), paramSpans{})
+}
+
+// unescapeParams converts parameters string to Params. Substrings of
+// the input are used when there is nothing to unescape.
+func unescapeParams(s string, spans paramSpans) Params {
+	if s == "" {
+		return nil
+	}
+	params := make(Params, 0, strings.Count(s, ";")+1)
+	it := paramIter{s: s, spans: spans}
+	for span, ok := it.next(); ok; span, ok = it.next() {
+		params = append(params, Param{unescape(span.name), unescape(span.value)})
+	}
+	return params
+}
+
+// lookupParam returns unescaped value of the first parameter with the
+// name without building Params.
+func lookupParam(s string, spans paramSpans, name string) (string, bool) {
+	it := paramIter{s: s, spans: spans}
+	for span, ok := it.next(); ok; span, ok = it.next() {
+		if strings.EqualFold(unescape(span.name), name) {
+			return unescape(span.value), true
+		}
+	}
+	return "", false
+}
+
+// Get returns value of the first parameter with the name and true
+// if parameter exists.
+func (p Params) Get(name string) (string, bool) {
+	if i := p.index(name); i >= 0 {
+		return p[i].Value, true
+	}
+	return "", false
+}
+
+// Has returns true if parameter with the name exists.
+func (p Params) Has(name string) bool {
+	return p.index(name) >= 0
+}
+
+// Set replaces value of the first parameter with the name or appends
+// new parameter. Empty value sets parameter without value.
+func (p *Params) Set(name, value string) {
+	if i := p.index(name); i >= 0 {
+		(*p)[i].Value = value
+		return
+	}
+	*p = append(*p, Param{name, value})
+}
+
+// Del removes all parameters with the name.
+func (p *Params) Del(name string) {
+	params := (*p)[:0]
+	for _, param := range *p {
+		if !strings.EqualFold(param.Name, name) {
+			params = append(params, param)
+		}
+	}
+	*p = params
+}
+
+// String returns escaped parameters list without leading ";".
+func (p Params) String() string {
+	return string(p.appendTo(nil))
+}
+
+func (p Params) appendTo(buf []byte) []byte {
+	for i, param := range p {
+		if i > 0 {
+			buf = append(buf, ';')
+		}
+		buf = appendEscaped(buf, param.Name, isParamChar)
+		if param.Value != "" {
+			buf = append(buf, '=')
+			buf = appendEscaped(buf, param.Value, isParamChar)
+		}
+	}
+	return buf
+}
+
+func (p Params) index(name string) int {
+	for i, param := range p {
+		if strings.EqualFold(param.Name, name) {
+			return i
+		}
+	}
+	return -1
+}
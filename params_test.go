@@ -0,0 +1,130 @@
+package uri
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseParams(t *testing.T) {
+	tests := []struct {
+		input  string
+		params Params
+	}{
+		{"", nil},
+		{";", nil},
+		{"lr", Params{{"lr", ""}}},
+		{";transport=tcp", Params{{"transport", "tcp"}}},
+		{"transport=tcp;lr;maddr=[::1]", Params{{"transport", "tcp"}, {"lr", ""}, {"maddr", "[::1]"}}},
+		{"x%3Dy=a%3Bb", Params{{"x=y", "a;b"}}},
+	}
+
+	for _, tc := range tests {
+		assert.Equal(t, tc.params, parseParams(tc.input), tc.input)
+	}
+}
+
+func TestParamsLookup(t *testing.T) {
+	p := parseParams("transport=tcp;lr;Method=INVITE")
+
+	v, ok := p.Get("TRANSPORT")
+	assert.True(t, ok)
+	assert.Equal(t, "tcp", v)
+
+	v, ok = p.Get("lr")
+	assert.True(t, ok)
+	assert.Equal(t, "", v)
+
+	_, ok = p.Get("ttl")
+	assert.False(t, ok)
+
+	assert.True(t, p.Has("method"))
+	assert.False(t, p.Has("maddr"))
+}
+
+func TestParamsModify(t *testing.T) {
+	p := parseParams("transport=tcp;lr;method=INVITE")
+
+	p.Set("Transport", "udp")
+	p.Set("ttl", "15")
+	assert.Equal(t, "transport=udp;lr;method=INVITE;ttl=15", p.String())
+
+	p.Del("LR")
+	p.Del("foo")
+	assert.Equal(t, "transport=udp;method=INVITE;ttl=15", p.String())
+
+	p.Set("x", "a;b c")
+	assert.Equal(t, "transport=udp;method=INVITE;ttl=15;x=a%3Bb%20c", p.String())
+
+	var empty Params
+	empty.Set("lr", "")
+	assert.Equal(t, "lr", empty.String())
+}
+
+func TestURIParams(t *testing.T) {
+	for _, parse := range []func(string) (*URI, error){RagelParse, Re2GoParse, LexerParse} {
+		uri, err := parse("sip:alice@atlanta.com;transport=tcp;lr")
+		assert.Nil(t, err)
+
+		params := uri.Params()
+		assert.Equal(t, Params{{"transport", "tcp"}, {"lr", ""}}, params)
+
+		params.Del("lr")
+		params.Set("maddr", "10.0.0.1")
+		uri.SetParams(params)
+		assert.Equal(t, "sip:alice@atlanta.com;transport=tcp;maddr=10.0.0.1", uri.String())
+
+		uri.SetParams(nil)
+		assert.Equal(t, "sip:alice@atlanta.com", uri.String())
+	}
+}
+
+func TestURIParamSpans(t *testing.T) {
+	tests := []struct {
+		input    string
+		spans    []paramSpan
+		recorded uint8
+	}{
+		{"sip:atlanta.com", nil, 0},
+		{"sip:atlanta.com;lr;x=%41?a=b", []paramSpan{{"lr", ""}, {"x", "%41"}}, 2},
+		{"sip:a;b=c;d@atlanta.com", nil, 0},
+		{"sip:a;b=c;d@atlanta.com;e=f", []paramSpan{{"e", "f"}}, 1},
+		{"sip:atlanta.com;a;b=1;c;d=2", []paramSpan{{"a", ""}, {"b", "1"}, {"c", ""}, {"d", "2"}}, 3},
+		{"sip:atlanta.com;a=" + strings.Repeat("x", 300) + ";b", []paramSpan{{"a", strings.Repeat("x", 300)}, {"b", ""}}, 0},
+		{"tel:+1234;ext=5;foo", []paramSpan{{"ext", "5"}, {"foo", ""}}, 2},
+		{"tel:1234;phone-context=example.com", []paramSpan{{"phone-context", "example.com"}}, 1},
+	}
+	for _, parse := range []func(string) (*URI, error){RagelParse, Re2GoParse, LexerParse} {
+		for _, tc := range tests {
+			uri, err := parse(tc.input)
+			if !assert.Nil(t, err, tc.input) {
+				continue
+			}
+			var spans []paramSpan
+			it := uri.iterParams()
+			for span, ok := it.next(); ok; span, ok = it.next() {
+				spans = append(spans, span)
+			}
+			assert.Equal(t, tc.spans, spans, tc.input)
+			assert.Equal(t, tc.recorded, uri.spans.n, tc.input)
+			assert.Equal(t, scanParams(uri.params), uri.spans, tc.input)
+		}
+
+		uri, _ := parse("sip:alice@atlanta.com;transport=tcp;lr;ttl=1;maddr=10.0.0.1")
+		allocs := testing.AllocsPerRun(10, func() {
+			uri.LR()
+			_, _ = uri.Transport()
+			_, _, _ = uri.TTL()
+			_, _ = uri.MAddr()
+		})
+		assert.Equal(t, 0.0, allocs)
+	}
+
+	for _, parse := range []func(string) (*URI, error){RagelParse, Re2GoParse} {
+		allocs := testing.AllocsPerRun(10, func() {
+			_, _ = parse("sip:alice@atlanta.com;transport=tcp;lr?subject=project")
+		})
+		assert.Equal(t, 1.0, allocs)
+	}
+}
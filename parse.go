@@ -0,0 +1,92 @@
+package uri
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Parser parses SIP URI.
+type Parser interface {
+	Parse(input string) (*URI, error)
+}
+
+// ParserFunc adapts parse function to Parser interface.
+type ParserFunc func(input string) (*URI, error)
+
+// Parse calls f(input).
+func (f ParserFunc) Parse(input string) (*URI, error) {
+	return f(input)
+}
+
+var (
+	parsersMu sync.RWMutex
+	parsers   = map[string]Parser{
+		"ragel":  ParserFunc(RagelParse),
+		"re2go":  ParserFunc(Re2GoParse),
+		"lexer":  ParserFunc(LexerParse),
+		"dummy":  ParserFunc(DummyParser),
+		"regexp": ParserFunc(RegexParse),
+	}
+	defaultParser = parsers[defaultBackend]
+	// strictParser is used by conversions and header parsing so that
+	// SetDefault with a lax backend does not change their results.
+	strictParser = parsers[defaultBackend]
+)
+
+// Parse parses SIP URI with default parser backend.
+// Default backend is "ragel" and can be changed with SetDefault or with
+// build tags uri_re2go or uri_lexer.
+func Parse(input string) (*URI, error) {
+	parsersMu.RLock()
+	p := defaultParser
+	parsersMu.RUnlock()
+	return p.Parse(input)
+}
+
+// Register makes parser backend available by the name.
+// Register panics if it is called twice with the same name or if
+// parser is nil.
+func Register(name string, parser Parser) {
+	parsersMu.Lock()
+	defer parsersMu.Unlock()
+	if parser == nil {
+		panic("uri: Register parser is nil")
+	}
+	if _, dup := parsers[name]; dup {
+		panic("uri: Register called twice for parser " + name)
+	}
+	parsers[name] = parser
+}
+
+// Lookup returns parser backend registered with the name.
+func Lookup(name string) (Parser, bool) {
+	parsersMu.RLock()
+	defer parsersMu.RUnlock()
+	p, ok := parsers[name]
+	return p, ok
+}
+
+// Parsers returns sorted list of registered parser backends names.
+func Parsers() []string {
+	parsersMu.RLock()
+	defer parsersMu.RUnlock()
+	names := make([]string, 0, len(parsers))
+	for name := range parsers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SetDefault sets parser backend used by Parse.
+func SetDefault(name string) error {
+	parsersMu.Lock()
+	defer parsersMu.Unlock()
+	p, ok := parsers[name]
+	if !ok {
+		return fmt.Errorf("uri: unknown parser %q", name)
+	}
+	defaultParser = p
+	return nil
+}
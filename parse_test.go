@@ -0,0 +1,88 @@
+package uri
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	uri, err := Parse("sip:alice@atlanta.com;transport=tcp")
+	assert.Nil(t, err)
+	expect := &URI{scheme: SIP, userinfo: "alice", hostport: "atlanta.com"}
+	expect.setParams("transport=tcp")
+	assert.Equal(t, expect, uri)
+
+	uri, err = Parse("foo")
+	assert.NotNil(t, err)
+	assert.Nil(t, uri)
+}
+
+func TestParseBackendsIdentical(t *testing.T) {
+	tests := []string{
+		"sip:alice@atlanta.com",
+		"sip:alice:secretword@atlanta.com;transport=tcp",
+		"sips:alice@atlanta.com?subject=project%20x&priority=urgent",
+		"sip:+1-212-555-1212:1234@gateway.com;user=phone",
+		"sips:gateway.com",
+		"sip:alice@192.0.2.4:8899",
+		"sip:atlanta.com;method=REGISTER?to=alice%40atlanta.com",
+		"sip:[2001:db8::10]:5070;lr;maddr=10.0.0.1",
+	}
+
+	for _, input := range tests {
+		expect, err := RagelParse(input)
+		assert.Nil(t, err, input)
+		for _, name := range Parsers() {
+			p, ok := Lookup(name)
+			assert.True(t, ok)
+			uri, err := p.Parse(input)
+			assert.Nil(t, err, name+": "+input)
+			assert.Equal(t, expect, uri, name+": "+input)
+		}
+	}
+}
+
+func TestParsersRegistry(t *testing.T) {
+	assert.Equal(t, []string{"dummy", "lexer", "ragel", "re2go", "regexp"}, Parsers())
+
+	_, ok := Lookup("foo")
+	assert.False(t, ok)
+
+	assert.Panics(t, func() { Register("ragel", ParserFunc(RagelParse)) })
+	assert.Panics(t, func() { Register("nil", nil) })
+
+	assert.NotNil(t, SetDefault("foo"))
+	assert.Nil(t, SetDefault("re2go"))
+	_, err := Parse("sip:atlanta.com;foo?bar")
+	assert.Contains(t, err.Error(), "invalid headers")
+	assert.Nil(t, SetDefault(defaultBackend))
+}
+
+func TestHelpersIgnoreDefault(t *testing.T) {
+	for _, name := range []string{"dummy", "regexp"} {
+		assert.Nil(t, SetDefault(name))
+
+		tel, err := RagelParse("tel:+1-201-555-0123")
+		assert.Nil(t, err)
+		sip, err := TelToSIP(tel, "gw.example.com")
+		assert.Nil(t, err, name)
+		assert.Equal(t, "sip:+12015550123@gw.example.com;user=phone", sip.String(), name)
+
+		tel, err = sip.ToTel()
+		assert.Nil(t, err, name)
+		assert.Equal(t, TEL, tel.Scheme(), name)
+
+		n := &NAPTR{Regexp: "!^.*$!sip:info@example.com!"}
+		uri, err := n.Rewrite("+12015550123")
+		assert.Nil(t, err, name)
+		assert.Equal(t, "example.com", uri.Host(), name)
+
+		na, err := ParseNameAddr("<sip:alice@atlanta.com;lr>")
+		assert.Nil(t, err, name)
+		assert.Equal(t, "atlanta.com", na.URI.Host(), name)
+		_, err = ParseNameAddr("<sip:alice@atlanta.com;foo?bar>")
+		assert.NotNil(t, err, name)
+	}
+	assert.Nil(t, SetDefault(defaultBackend))
+}
@@ -1,7 +1,7 @@
 package uri
 
 import (
-	"fmt"
+	"strings"
 )
 
 %% machine uri;
@@ -14,25 +14,49 @@ func RagelParse(str string) (*URI, error) {
 	limit := len(data)
 	p := 0 // data pointer
 	m := 0 // marker for matching start position
+	u := 0 // userinfo start position
+	e := 0 // parameter name end position
+	o := 0 // port start position
+	isub, ext, postd := false, false, false
+	port := 0 // port value
 	pe := limit // data end pointer
 	eof := limit // End of data
 %%{
 	action sm   { m = p }
-	action sip  { uri.scheme   = SIP      }
-	action sips { uri.scheme   = SIPS     }
-	action usrp { uri.userinfo = str[m:p] }
+	action sip  { uri.scheme   = SIP;  u = p + 1 }
+	action sips { uri.scheme   = SIPS; u = p + 1 }
+	action tel  { uri.scheme   = TEL }
+	action num  { uri.userinfo = str[m:p]; m = p }
+	action tprm { uri.params   = str[m+1:p] }
+	# isub, ext and postd are given at most once
+	action isub { if isub { fgoto *uri_error; }; isub = true }
+	action ext  { if ext { fgoto *uri_error; }; ext = true }
+	action pstd { if postd { fgoto *uri_error; }; postd = true }
+	# "@" is allowed in sip URI only after userinfo
+	action usr  { if strings.IndexByte(str[p+1:], '@') < 0 { fgoto *uri_en_hostpart; } }
+	action usrp { uri.userinfo = str[u:p] }
+	action hst  { fnext *uri_en_hostpart; }
+	action prt  { o = p; port = 0 }
+	# port out of range fails at port start
+	action pdg  {
+		if port = port*10 + int(str[p]-'0'); port > 0xFFFF {
+			p = o
+			fgoto *uri_error;
+		}
+	}
 	action hstp { uri.hostport = str[m:p] }
-	action prms { uri.params   = str[m:p] }
+	action prms { uri.params   = strings.TrimPrefix(str[m:p], ";") }
 	action hdrs { uri.headers  = str[m:p] }
+	# spans are offsets in params which start after ";" at m
+	action pne  { e = p }
+	action prm  { uri.spans.add(e-m-1, p-m-1) }
 
   unreserved      = alnum | [\-_.!~*'()];
   escaped         = "%" xdigit xdigit;
   reserved        = [;/?:@&=+$,];
   user_unreserved = [&=+$,;?/];
-	hexseq          = xdigit{1,4} ( ":" xdigit{1,4} )*;
-	hexpart         = hexseq | hexseq "::" hexseq? | "::" hexseq?;
 	paramchar       = [[\]/:&+$] | unreserved | escaped;
-	uriparam        = paramchar+ ("=" paramchar+)?;
+	uriparam        = (paramchar+ %pne ("=" paramchar+)?) %prm;
 	hdrchar         = [[\]/?:+$] | unreserved | escaped;
 	header          = hdrchar+ "=" hdrchar*;
 
@@ -40,14 +64,26 @@ func RagelParse(str string) (*URI, error) {
 	toplabel        = alpha | alpha ( alnum | "-" )* alnum;
 	hostname        = ( domainlabel "." )* toplabel "."?;
 
-	IPv4address     = digit{1,3} "." digit{1,3} "." digit{1,3} "." digit{1,3};
-	IPv6address     = hexpart ( ":" IPv4address )?;
+	dec_octet       = digit{1,2} | [01] digit{2} | "2" [0-4] digit | "25" [0-5]; # 0-255 with leading zeros of 1*3DIGIT
+	IPv4address     = dec_octet "." dec_octet "." dec_octet "." dec_octet;
+	# RFC4291 #2.2 text forms as written in RFC3986 #3.2.2
+	h16             = xdigit{1,4};
+	ls32            = h16 ":" h16 | IPv4address;
+	IPv6address     =                               ( h16 ":" ){6} ls32
+	                |                          "::" ( h16 ":" ){5} ls32
+	                |                 ( h16 )? "::" ( h16 ":" ){4} ls32
+	                | ( ( h16 ":" ){,1} h16 )? "::" ( h16 ":" ){3} ls32
+	                | ( ( h16 ":" ){,2} h16 )? "::" ( h16 ":" ){2} ls32
+	                | ( ( h16 ":" ){,3} h16 )? "::"   h16 ":"       ls32
+	                | ( ( h16 ":" ){,4} h16 )? "::"                 ls32
+	                | ( ( h16 ":" ){,5} h16 )? "::"                 h16
+	                | ( ( h16 ":" ){,6} h16 )? "::";
 	IPv6reference   = "[" IPv6address "]";
 
 	user            = ( unreserved | escaped | user_unreserved )+;
   password        = ( unreserved | escaped | [&=+$,] )*;
 	host            = hostname | IPv4address | IPv6reference;
-  port            = digit{1,5};
+  port            = digit+ >prt $pdg;
 	
 	scheme   = ("sip" %sip | "sips" %sips) ":";
 	userinfo = user >sm (":" password )? %usrp "@";
@@ -55,7 +91,32 @@ func RagelParse(str string) (*URI, error) {
 	params   = (";" uriparam)* >sm %prms;
 	headers  = "?" (header ("&" header)*) >sm %hdrs;
 
-	uri := scheme userinfo? hostport params headers?;
+	# rfc3966 tel URI, number is stored as userinfo
+	visual_separator = [\-.()];
+	phonedigit       = digit | visual_separator;
+	phonedigit_hex   = xdigit | "*" | "%23" | visual_separator; # "#" is not allowed in URI, only "%23"
+	global_number    = "+" phonedigit* digit phonedigit*;
+	local_number     = phonedigit_hex* ( xdigit | "*" | "%23" ) phonedigit_hex*;
+	uric             = [/?:@&=+$,] | unreserved | escaped;
+	postdchar        = phonedigit | [*ABCDPWabcdpw] | "%23";
+	telname          = ( alnum | "-" )+ - ( "isub"i | "ext"i | "postd"i | "phone-context"i );
+
+	isub      = "isub"i %pne %isub "=" uric+;
+	ext       = "ext"i %pne %ext "=" ( phonedigit* digit phonedigit* );
+	postd     = "postd"i %pne %pstd "=" postdchar+;
+	context   = "phone-context"i %pne "=" ( hostname | global_number );
+	telparam  = telname %pne ( "=" paramchar+ )?;
+	par       = ";" ( isub | ext | postd | telparam ) %prm %tprm;
+	contextpar = ";" context %prm %tprm;
+
+	telephone_subscriber = global_number >sm %num par*
+	                     | local_number >sm %num par* contextpar par*;
+
+	sip_uri = scheme @usr userinfo @hst;
+	tel_uri = "tel"i ":" @tel telephone_subscriber;
+
+	main := sip_uri | tel_uri;
+	hostpart := hostport params headers?;
 }%%
   %% write init;
 	%% write exec;
@@ -63,7 +124,7 @@ func RagelParse(str string) (*URI, error) {
 	if cs >= uri_first_final {
 		return uri, nil
 	}
-	return nil, fmt.Errorf("Invalid URI '%s'.", str)
+	return nil, errorAt(str, p)
 }
 
 /* vim: set filetype=go : */
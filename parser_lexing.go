@@ -1,40 +1,72 @@
 package uri
 
 import (
-	"fmt"
 	"regexp"
 	"strings"
-	"time"
 )
 
+// Token is kind of SIP URI component found by Scanner.
 type Token uint8
 
 const (
-	tUnknown Token = iota
-	tSip
-	tSips
-	tUserinfo
-	tHost
-	tPort
-	tParams
-	tHeader
-	tEOF
+	TokenUnknown Token = iota
+	TokenSIP
+	TokenSIPS
+	TokenTel
+	TokenUserinfo
+	TokenNumber
+	TokenHost
+	TokenPort
+	TokenParams
+	TokenHeaders
+	TokenEOF
+	TokenError
 )
 
+var tokenNames = [...]string{
+	TokenUnknown:  "unknown",
+	TokenSIP:      "sip",
+	TokenSIPS:     "sips",
+	TokenTel:      "tel",
+	TokenUserinfo: "userinfo",
+	TokenNumber:   "number",
+	TokenHost:     "host",
+	TokenPort:     "port",
+	TokenParams:   "params",
+	TokenHeaders:  "headers",
+	TokenEOF:      "EOF",
+	TokenError:    "error",
+}
+
+func (t Token) String() string {
+	if int(t) < len(tokenNames) {
+		return tokenNames[t]
+	}
+	return "unknown"
+}
+
 const eof = -1
 
+// Item is token with its value and byte offset in the input.
 type Item struct {
-	token Token
-	value string
+	token  Token
+	value  string
+	offset int
 }
 
+// lexer runs state functions on demand until item is emitted.
+// State emits at most two items so fixed size queue is used.
 type lexer struct {
 	input  string
 	limit  int
 	cursor int
 	marker int
-	items  chan Item
-	err    chan string
+	state  lexFunc
+	items  [4]Item
+	head   int
+	tail   int
+	err    *ParseError
+	params paramSpans
 }
 
 type lexFunc func() lexFunc
@@ -43,66 +75,88 @@ func LexerParse(data string) (*URI, error) {
 	uri := &URI{}
 
 	l := newLexer(data)
-	go l.run()
-mainLoop:
 	for {
-		select {
-		case item := <-l.items:
-			if item.token == tEOF {
-				break mainLoop
-			}
-			uri.setSegment(item)
-		case err := <-l.err:
-			return nil, fmt.Errorf("Invalid uri '%s': %s", data, err)
-		case <-time.After(time.Millisecond * 10):
-			return nil, fmt.Errorf("Invalid uri '%s': timeout parsing", data)
+		item := l.nextItem()
+		switch item.token {
+		case TokenEOF:
+			uri.spans = l.params
+			return uri, nil
+		case TokenError:
+			return nil, l.err
 		}
+		uri.setSegment(item)
 	}
-	return uri, nil
 }
 
 func (uri *URI) setSegment(item Item) {
 	switch item.token {
-	case tSip:
+	case TokenSIP:
 		uri.scheme = SIP
-	case tSips:
+	case TokenSIPS:
 		uri.scheme = SIPS
-	case tUserinfo:
+	case TokenTel:
+		uri.scheme = TEL
+	case TokenUserinfo, TokenNumber:
 		uri.userinfo = item.value
-	case tHost:
+	case TokenHost:
 		uri.hostport = item.value
-	case tPort:
-		uri.hostport = fmt.Sprintf("%s:%s", uri.hostport, item.value)
-	case tParams:
+	case TokenPort:
+		uri.hostport += ":" + item.value
+	case TokenParams:
 		uri.params = item.value
-	case tHeader:
+	case TokenHeaders:
 		uri.headers = item.value
 	}
 }
 
 func newLexer(data string) *lexer {
-	return &lexer{
+	l := &lexer{
 		input:  data,
 		limit:  len(data),
 		cursor: 0,
 		marker: 0,
-		items:  make(chan Item),
-		err:    make(chan string),
 	}
+	l.state = l.lexScheme
+	return l
 }
 
-func (l *lexer) run() {
-	for state := l.lexScheme; state != nil; {
-		state = state()
+// nextItem returns next item. Lexer states are run until item is
+// emitted. After TokenEOF or TokenError item TokenEOF is returned.
+func (l *lexer) nextItem() Item {
+	for l.head == l.tail {
+		if l.state == nil {
+			return Item{TokenEOF, "", l.limit}
+		}
+		l.head, l.tail = 0, 0
+		l.state = l.state()
 	}
+	item := l.items[l.head]
+	l.head++
+	return item
+}
+
+func (l *lexer) push(item Item) {
+	l.items[l.tail] = item
+	l.tail++
 }
 
 func (l *lexer) emit(tk Token) {
-	l.items <- Item{tk, l.input[l.marker:l.cursor]}
+	l.push(Item{tk, l.input[l.marker:l.cursor], l.marker})
+}
+
+func (l *lexer) emitEOF() {
+	l.push(Item{TokenEOF, "", l.limit})
+}
+
+// fail reports failure at the first octet that can not continue valid
+// URI. Component is detected the same way as for generated parsers.
+func (l *lexer) fail(offset int) {
+	l.failWith(errorAt(l.input, offset))
 }
 
-func (l *lexer) errorf(pattern string, v ...interface{}) {
-	l.err <- fmt.Sprintf(pattern, v...)
+func (l *lexer) failWith(err *ParseError) {
+	l.err = err
+	l.push(Item{TokenError, l.input[err.Offset:], err.Offset})
 }
 
 func (l *lexer) next() int {
@@ -123,18 +177,78 @@ func (l *lexer) current() int {
 
 func (l *lexer) lexScheme() lexFunc {
 	if strings.HasPrefix(l.input, "sip:") {
-		l.cursor = 4
-		l.emit(tSip)
+		l.cursor = 3
+		l.emit(TokenSIP)
 	} else if strings.HasPrefix(l.input, "sips:") {
-		l.cursor = 5
-		l.emit(tSips)
+		l.cursor = 4
+		l.emit(TokenSIPS)
+	} else if hasTelScheme(l.input) {
+		l.cursor = 3
+		l.emit(TokenTel)
+		l.cursor++ // skip ':'
+		return l.lexNumber
 	} else {
-		l.errorf("invalid scheme")
+		l.fail(schemePrefix(l.input))
 		return nil
 	}
+	l.cursor++ // skip ':'
 	return l.lexUserinfo
 }
 
+// schemePrefix returns length of the longest prefix of s that starts
+// "sips:" or case-insensitive "tel:".
+func schemePrefix(s string) int {
+	n := 0
+	for _, scheme := range []string{"sips:", "tel:"} {
+		i := 0
+		for i < len(s) && i < len(scheme) && (s[i] == scheme[i] || scheme == "tel:" && strings.EqualFold(s[i:i+1], scheme[i:i+1])) {
+			i++
+		}
+		if i > n {
+			n = i
+		}
+	}
+	return n
+}
+
+// rfc3966 #3 URI Syntax
+// telephone-uri        = "tel:" telephone-subscriber
+// telephone-subscriber = global-number / local-number
+// global-number        = global-number-digits *par
+// local-number         = local-number-digits *par context *par
+// Parameters depend on the number and on each other, so parseSubscriber
+// checks the whole telephone-subscriber.
+func (l *lexer) lexNumber() lexFunc {
+	l.marker = l.cursor
+	_, err := parseSubscriber(l.input, l.cursor)
+	perr, _ := err.(*ParseError)
+	if perr != nil && perr.Err == ErrInvalidNumber {
+		l.fail(perr.Offset)
+		return nil
+	}
+	l.cursor = indexParamEnd(l.input, l.cursor)
+	l.emit(TokenNumber)
+	if perr != nil {
+		l.fail(perr.Offset)
+		return nil
+	}
+	return l.lexTelParams
+}
+
+// par = parameter / extension / isdn-subaddress
+func (l *lexer) lexTelParams() lexFunc {
+	if l.current() == eof {
+		l.emitEOF()
+		return nil
+	}
+	l.marker = l.cursor + 1
+	l.cursor = l.limit
+	l.params = scanParams(l.input[l.marker:])
+	l.emit(TokenParams)
+	l.emitEOF()
+	return nil
+}
+
 // rfc3261  #25.1 Basic Rules
 // userinfo         =  ( user / telephone-subscriber ) [ ":" password ] "@"
 // user             =  1*( unreserved / escaped / user-unreserved )
@@ -143,27 +257,30 @@ func (l *lexer) lexScheme() lexFunc {
 func (l *lexer) lexUserinfo() lexFunc {
 	l.marker = l.cursor
 
-	atIndex := strings.IndexByte(l.input, '@')
+	atIndex := strings.IndexByte(l.input[l.cursor:], '@')
 	if atIndex == -1 {
 		// continue with host:port part
 		return l.lexHostport
 	}
+	atIndex += l.cursor
 
-	for ; l.cursor < atIndex; l.cursor++ {
-		c := l.input[l.cursor]
-		if isUnreserved(c) {
-			continue
-		}
-		// user unreserved and escape and ':'
-		switch c {
-		case '&', '=', '+', '$', ',', ';', '?', '/', '%', ':':
-			continue
+	if !l.scan(isUserChar) || l.cursor == l.marker {
+		l.fail(l.cursor)
+		return nil
+	}
+	if l.current() == ':' {
+		l.cursor++
+		if !l.scan(isPasswordChar) {
+			l.fail(l.cursor)
+			return nil
 		}
-		l.errorf("invalid userinfo ...%s", l.input[l.cursor:])
+	}
+	if l.cursor != atIndex {
+		l.fail(l.cursor)
 		return nil
 	}
 
-	l.emit(tUserinfo)
+	l.emit(TokenUserinfo)
 	l.cursor++ // skip '@'
 	return l.lexHostport
 }
@@ -175,96 +292,74 @@ func (l *lexer) lexUserinfo() lexFunc {
 // domainlabel      =  alphanum
 //                     / alphanum *( alphanum / "-" ) alphanum
 // toplabel         =  ALPHA / ALPHA *( alphanum / "-" ) alphanum
-// IPv4address      =  1*3DIGIT "." 1*3DIGIT "." 1*3DIGIT "." 1*3DIGIT
+// IPv4address      =  dec-octet "." dec-octet "." dec-octet "." dec-octet
+// dec-octet        =  1*3DIGIT ; 0-255, leading zeros allowed
 // IPv6reference    =  "[" IPv6address "]"
-// IPv6address      =  hexpart [ ":" IPv4address ]
-// hexpart          =  hexseq / hexseq "::" [ hexseq ] / "::" [ hexseq ]
-// hexseq           =  hex4 *( ":" hex4)
-// hex4             =  1*4HEXDIG
-// port             =  1*DIGIT
+// IPv6address      =  RFC4291 #2.2 forms, see lexIPv6
+// port             =  1*DIGIT ; 0-65535
 func (l *lexer) lexHostport() lexFunc {
 	l.marker = l.cursor
-
-	c := l.current()
-	if c == eof {
-		l.errorf("invalid host/port")
-		return nil
-	}
-
-	if c == '[' {
+	if l.current() == '[' {
 		l.cursor++
 		return l.lexIPv6
 	}
-
-	if length, match := parseIPv4(l.input[l.marker:]); match {
-		l.cursor += length
-		l.emit(tHost)
-		return l.lexPort
-	}
-
-	if isAlphaNum(byte(c)) {
-		return l.lexHostname
-	}
-
-	l.errorf("invalid host part")
-	return nil
+	return l.lexHostname
 }
 
 // hostname         =  *( domainlabel "." ) toplabel [ "." ]
 // domainlabel      =  alphanum / alphanum *( alphanum / "-" ) alphanum
 // toplabel         =  ALPHA / ALPHA *( alphanum / "-" ) alphanum
+// Octets of IPv4address are domain labels too, so host is scanned as
+// hostname and checked for both.
 func (l *lexer) lexHostname() lexFunc {
-	for _, c := range l.input[l.marker:] {
-		if isAlphaNum(byte(c)) || c == '-' || c == '.' {
-			l.cursor++
-			continue
-		}
-		if c == ':' {
-			l.emit(tHost)
-			return l.lexPort
-		}
-		if c == ';' {
-			l.emit(tHost)
-			return l.lexParams
-		}
-		if c == '?' {
-			l.emit(tHost)
-			return l.lexHeaders
-		}
-		l.errorf("invalid hostname")
+	end, ok := scanHostname(l.input, l.cursor)
+	host := l.input[l.cursor:end]
+	if n, ipv4 := parseIPv4(host); ipv4 && n == len(host) {
+		ok = true
+	}
+	l.cursor = end
+	if !ok || !isHostEnd(l.input[end:]) {
+		l.fail(l.cursor)
 		return nil
 	}
-	l.emit(tHost)
-	l.emit(tEOF)
-	return nil
+	l.emit(TokenHost)
+	return l.lexPort
 }
 
 // IPv6reference  =  "[" IPv6address "]"
-// IPv6address    =  hexpart [ ":" IPv4address ]
-// hexpart        =  hexseq / hexseq "::" [ hexseq ] / "::" [ hexseq ]
-// hexseq         =  hex4 *( ":" hex4)
-// hex4           =  1*4HEXDIG
+// IPv6address    =                            6( h16 ":" ) ls32
+//                /                       "::" 5( h16 ":" ) ls32
+//                / [               h16 ] "::" 4( h16 ":" ) ls32
+//                / [ *1( h16 ":" ) h16 ] "::" 3( h16 ":" ) ls32
+//                / [ *2( h16 ":" ) h16 ] "::" 2( h16 ":" ) ls32
+//                / [ *3( h16 ":" ) h16 ] "::"    h16 ":"   ls32
+//                / [ *4( h16 ":" ) h16 ] "::"              ls32
+//                / [ *5( h16 ":" ) h16 ] "::"              h16
+//                / [ *6( h16 ":" ) h16 ] "::"
+// ls32           =  ( h16 ":" h16 ) / IPv4address
+// h16            =  1*4HEXDIG
 func (l *lexer) lexIPv6() lexFunc {
-	for _, c := range l.input[l.cursor:] {
+	start := l.cursor
+	for l.cursor < l.limit && isIPv6Prefix(l.input[start:l.cursor+1]) {
 		l.cursor++
-		if c == ']' {
-			l.emit(tHost)
-			return l.lexPort
-		}
-		if isHex(byte(c)) || c == ':' || c == '.' {
-			continue
-		}
-		l.errorf("invalid host part ipv6 >%c<", c)
+	}
+	if !isIPv6(l.input[start:l.cursor]) || l.current() != ']' {
+		l.fail(l.cursor)
 		return nil
 	}
-	l.errorf("invalid host part ipv6")
-	return nil
+	l.cursor++
+	if !isHostEnd(l.input[l.cursor:]) {
+		l.fail(l.cursor)
+		return nil
+	}
+	l.emit(TokenHost)
+	return l.lexPort
 }
 
 func (l *lexer) lexPort() lexFunc {
 	c := l.current()
 	if c == eof {
-		l.emit(tEOF)
+		l.emitEOF()
 		return nil
 	}
 	if c != ':' {
@@ -272,13 +367,18 @@ func (l *lexer) lexPort() lexFunc {
 	}
 	l.cursor++
 	l.marker = l.cursor
-	n, c, ok := dtoi(l.input[l.marker:])
-	if !ok || n > 0xFFFF {
-		l.errorf("invalid port %s", l.input[l.marker:l.marker+c])
+	for l.cursor < l.limit && isNum(l.input[l.cursor]) {
+		l.cursor++
 	}
-
-	l.cursor += c
-	l.emit(tPort)
+	if l.cursor == l.marker {
+		l.fail(l.cursor)
+		return nil
+	}
+	if n, _, _ := dtoi(l.input[l.marker:l.cursor]); n > 0xFFFF {
+		l.fail(l.marker)
+		return nil
+	}
+	l.emit(TokenPort)
 	return l.lexParams
 }
 
@@ -289,37 +389,40 @@ func (l *lexer) lexParams() lexFunc {
 	l.marker = l.cursor
 	c := l.current()
 	if c == eof {
-		l.emit(tEOF)
+		l.emitEOF()
 		return nil
 	}
 	if c == '?' {
 		return l.lexHeaders
 	}
 	if c != ';' {
-		l.errorf("invalid params")
+		l.fail(l.cursor)
 		return nil
 	}
-	l.cursor++
-	l.marker = l.cursor
-	for _, c := range l.input[l.cursor:] {
-		if c == '?' {
-			l.emit(tParams)
-			return l.lexHeaders
-		}
+	l.marker = l.cursor + 1
+	for l.current() == ';' {
 		l.cursor++
-		if isUnreserved(byte(c)) || c == '=' || c == ';' || c == '%' {
-			continue
+		if !l.scanToken(isParamChar) {
+			l.fail(l.cursor)
+			return nil
 		}
-		switch c {
-		case '[', ']', '/', ':', '&', '+', '$':
-			continue
+		nameEnd := l.cursor
+		if l.current() == '=' {
+			l.cursor++
+			if !l.scanToken(isParamChar) {
+				l.fail(l.cursor)
+				return nil
+			}
 		}
-		l.errorf("invalid param ...>%s", l.input[l.cursor:])
+		l.params.add(nameEnd-l.marker, l.cursor-l.marker)
+	}
+	c = l.current()
+	if c != eof && c != '?' {
+		l.fail(l.cursor)
 		return nil
 	}
-	l.emit(tParams)
-	l.emit(tEOF)
-	return nil
+	l.emit(TokenParams)
+	return l.lexHeaders
 }
 
 // hdrchar = [[\]/?:+$] | unreserved | escaped;
@@ -329,32 +432,153 @@ func (l *lexer) lexHeaders() lexFunc {
 	l.marker = l.cursor
 	c := l.current()
 	if c == eof {
-		l.emit(tEOF)
+		l.emitEOF()
 		return nil
 	}
 	if c != '?' {
-		l.errorf("invalid headers")
+		l.fail(l.cursor)
 		return nil
 	}
-	l.cursor++
-	l.marker = l.cursor
-	for _, c := range l.input[l.marker:] {
+	l.marker = l.cursor + 1
+	for sep := '?'; l.current() == int(sep); sep = '&' {
+		l.cursor++
+		if !l.scanToken(isHeaderChar) || l.current() != '=' {
+			l.fail(l.cursor)
+			return nil
+		}
 		l.cursor++
-		if isUnreserved(byte(c)) || c == '=' || c == '%' || c == '&' {
+		if !l.scan(isHeaderChar) {
+			l.fail(l.cursor)
+			return nil
+		}
+	}
+	if l.current() != eof {
+		l.fail(l.cursor)
+		return nil
+	}
+	l.emit(TokenHeaders)
+	l.emitEOF()
+	return nil
+}
+
+// scan advances cursor over allowed octets and escaped sequences.
+// Returns false if malformed escaped sequence is found, cursor is then
+// at its first unexpected octet.
+func (l *lexer) scan(allowed func(byte) bool) bool {
+	var ok bool
+	l.cursor, ok = scanEscaped(l.input, l.cursor, allowed)
+	return ok
+}
+
+// scanToken is scan that requires at least one octet.
+func (l *lexer) scanToken(allowed func(byte) bool) bool {
+	start := l.cursor
+	return l.scan(allowed) && l.cursor > start
+}
+
+// isHostEnd checks if host is followed by port, params, headers or
+// end of input.
+func isHostEnd(s string) bool {
+	return s == "" || s[0] == ':' || s[0] == ';' || s[0] == '?'
+}
+
+// isHostname checks domain labels and top label of the hostname.
+func isHostname(s string) bool {
+	labels := strings.Split(strings.TrimSuffix(s, "."), ".")
+	for _, label := range labels {
+		if label == "" || !isAlphaNum(label[0]) || !isAlphaNum(label[len(label)-1]) {
+			return false
+		}
+	}
+	c := labels[len(labels)-1][0]
+	return ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+}
+
+// scanHostname returns end of hostname prefix of s starting at pos and
+// whether it is complete hostname. Scan stops at the first octet that
+// can not continue hostname.
+func scanHostname(s string, pos int) (int, bool) {
+	end := pos
+	for ; end < len(s); end++ {
+		c := s[end]
+		if isAlphaNum(c) {
 			continue
 		}
-		switch c {
-		case '[', ']', '/', '?', ':', '+', '$':
+		// label starts and ends with alphanum
+		if end > pos && (isAlphaNum(s[end-1]) || c == '-' && s[end-1] == '-') && (c == '-' || c == '.') {
 			continue
 		}
-		l.errorf("invalid header ...>%s", l.input[l.cursor:])
+		break
 	}
-	l.emit(tHeader)
-	l.emit(tEOF)
-	return nil
+	return end, isHostname(s[pos:end])
+}
+
+// isIPv6Prefix checks that s can be continued to IPv6address. Every
+// such prefix is completed by one of the shortest suffixes below.
+func isIPv6Prefix(s string) bool {
+	if len(s) > len("ffff:ffff:ffff:ffff:ffff:ffff:255.255.255.255") {
+		return false
+	}
+	for _, suffix := range []string{"", "0", ":", "::", ":0", ".0", "0.0", ".0.0", "0.0.0"} {
+		if isIPv6(s + suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// isIPv6 checks IPv6address as defined in rfc4291 #2.2: eight h16
+// groups, or less with a single "::" compressing zero groups. IPv4 tail
+// counts as two groups.
+func isIPv6(s string) bool {
+	groups := 0
+	if idx := strings.LastIndexByte(s, ':'); idx >= 0 && strings.IndexByte(s[idx:], '.') > 0 {
+		if n, ok := parseIPv4(s[idx+1:]); !ok || n != len(s)-idx-1 {
+			return false
+		}
+		if s = s[:idx]; strings.HasSuffix(s, ":") {
+			s += ":" // keep "::" of "::ffff:1.2.3.4"
+		}
+		groups = 2
+	}
+	head, tail := s, ""
+	compressed := false
+	if idx := strings.Index(s, "::"); idx >= 0 {
+		head, tail = s[:idx], s[idx+2:]
+		compressed = true
+	}
+	for _, seq := range []string{head, tail} {
+		if seq == "" {
+			continue
+		}
+		if !isH16seq(seq) {
+			return false
+		}
+		groups += strings.Count(seq, ":") + 1
+	}
+	if compressed {
+		return groups < 8
+	}
+	return groups == 8
+}
+
+// h16 *( ":" h16 )
+// h16 = 1*4HEXDIG
+func isH16seq(s string) bool {
+	for _, h16 := range strings.Split(s, ":") {
+		if len(h16) == 0 || len(h16) > 4 {
+			return false
+		}
+		for i := 0; i < len(h16); i++ {
+			if !isHex(h16[i]) {
+				return false
+			}
+		}
+	}
+	return true
 }
 
-// IPv4address    =  1*3DIGIT "." 1*3DIGIT "." 1*3DIGIT "." 1*3DIGIT
+// IPv4address    =  dec-octet "." dec-octet "." dec-octet "." dec-octet
 // modified copy from go source net/ip.go
 func parseIPv4(data string) (int, bool) {
 	input := data
@@ -371,7 +595,7 @@ func parseIPv4(data string) (int, bool) {
 			l++
 		}
 		n, c, ok := dtoi(input)
-		if !ok || n > 0xFF {
+		if !ok || n > 0xFF || c > 3 {
 			return 0, false
 		}
 		input = input[c:]
@@ -440,6 +664,9 @@ func DummyParser(data string) (*URI, error) {
 	if err := u.parseHostport(); err != nil {
 		return nil, err
 	}
+	if u.uri.hostport == "" {
+		return nil, newParseError(data, len(data)-len(u.input), ErrInvalidHostport)
+	}
 
 	if err := u.parseParams(); err != nil {
 		return nil, err
@@ -453,7 +680,7 @@ func DummyParser(data string) (*URI, error) {
 }
 
 func (u *URIRegex) parseHeaders() error {
-	if u.input[0] != '?' {
+	if len(u.input) == 0 || u.input[0] != '?' {
 		return nil
 	}
 	u.uri.headers = u.input[1:]
@@ -461,15 +688,15 @@ func (u *URIRegex) parseHeaders() error {
 }
 
 func (u *URIRegex) parseParams() error {
-	if u.input[0] != ';' {
+	if len(u.input) == 0 || u.input[0] != ';' {
 		return nil
 	}
 	if idx := strings.IndexByte(u.input, '?'); idx >= 0 {
-		u.uri.params = u.input[1:idx]
+		u.uri.setParams(u.input[1:idx])
 		u.input = u.input[idx:]
 		return nil
 	}
-	u.uri.params = u.input[1:]
+	u.uri.setParams(u.input[1:])
 	return nil
 }
 
@@ -513,7 +740,7 @@ func (u *URIRegex) parseScheme() error {
 		u.input = u.input[5:]
 		return nil
 	}
-	return fmt.Errorf("Invalid URI scheme")
+	return newParseError(u.input, 0, ErrInvalidScheme)
 }
 
 func RegexParse(input string) (*URI, error) {
@@ -530,12 +757,12 @@ func RegexParse(input string) (*URI, error) {
 	}
 	matches := re.FindStringSubmatch(input)
 	if len(matches) == 0 {
-		return nil, fmt.Errorf("Invalid URI")
+		if !strings.HasPrefix(input, "sip:") && !strings.HasPrefix(input, "sips:") {
+			return nil, newParseError(input, 0, ErrInvalidScheme)
+		}
+		return nil, newParseError(input, strings.IndexByte(input, ':')+1, ErrInvalidHostport)
 	}
 	idx := re.SubexpIndex("scheme")
-	if idx == -1 {
-		return nil, fmt.Errorf("Failed to parse URI")
-	}
 	switch matches[idx] {
 	case "sip":
 		uri.scheme = SIP
@@ -550,7 +777,7 @@ func RegexParse(input string) (*URI, error) {
 	uri.hostport = matches[idx]
 
 	if idx := re.SubexpIndex("params"); idx > 0 {
-		uri.params = matches[idx]
+		uri.setParams(matches[idx])
 	}
 
 	if idx := re.SubexpIndex("headers"); idx > 0 {
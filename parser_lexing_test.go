@@ -62,7 +62,18 @@ func TestLexerParseURIFail(t *testing.T) {
 		{"sipsfoo", "invalid scheme"},
 		{"sip:1.1.1.1:a22", "invalid"},
 		{"sip:atlanta.com;foo\"", "invalid"},
-		// {"sip:atlanta.com;foo?bar", "invalid headers"},
+		{"sip:atlanta.com;foo?bar", "invalid headers"},
+		{"sip:atlanta.com?a=b&c", "invalid headers"},
+		{"sip:atlanta.com?", "invalid headers"},
+		{"sip:atlanta.com;", "invalid params"},
+		{"sip:atlanta.com;lr=", "invalid params"},
+		{"sip:%zz@atlanta.com", "invalid userinfo"},
+		{"sip:a:b:c@atlanta.com", "invalid userinfo"},
+		{"sip:alice@atlanta.com:99999;lr", "invalid port"},
+		{"sip:A..", "invalid host"},
+		{"sip:0.0.0.0000", "invalid host"},
+		{"sip:alice@at\u012banta.com", "invalid host"},
+		{"sip:[::1]x", "invalid host"},
 		{"sip:;foo?bar", "invalid host"},
 		{"sip:?foo", "invalid host"},
 	}
@@ -75,6 +86,30 @@ func TestLexerParseURIFail(t *testing.T) {
 	}
 }
 
+func TestLexerNextItem(t *testing.T) {
+	l := newLexer("sip:alice@atlanta.com:5060;lr?subject=hi")
+	expected := []Item{
+		{TokenSIP, "sip", 0},
+		{TokenUserinfo, "alice", 4},
+		{TokenHost, "atlanta.com", 10},
+		{TokenPort, "5060", 22},
+		{TokenParams, "lr", 27},
+		{TokenHeaders, "subject=hi", 30},
+		{TokenEOF, "", 40},
+		{TokenEOF, "", 40},
+	}
+	for _, item := range expected {
+		assert.Equal(t, item, l.nextItem())
+	}
+
+	l = newLexer("sip:alice@atl_anta.com")
+	for item := l.nextItem(); item.token != TokenError; item = l.nextItem() {
+		assert.NotEqual(t, TokenEOF, item.token)
+	}
+	assert.Equal(t, TokenEOF, l.nextItem().token)
+	assert.ErrorIs(t, l.err, ErrInvalidHostport)
+}
+
 func BenchmarkLexerParse(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		LexerParse("sips:bob:pa55w0rd@example.com:8080;user=phone?X-t=foo")
@@ -142,6 +177,14 @@ func TestDummyParser(t *testing.T) {
 	}
 }
 
+func TestDummyParserFail(t *testing.T) {
+	for _, input := range []string{"sip:", "sips:", "sip:alice@", "sip:alice@;lr"} {
+		uri, err := DummyParser(input)
+		assert.Nil(t, uri, input)
+		assert.Contains(t, err.Error(), "invalid host", input)
+	}
+}
+
 func TestRegexParse(t *testing.T) {
 	tests := []struct {
 		input                               string
@@ -202,6 +245,8 @@ func TestLexerScanIPv4(t *testing.T) {
 		{"8.8d.8.8", 0, false},
 		{"8.8.8.256", 0, false},
 		{"8000.8.8.56", 0, false},
+		{"0.0.0.0000", 0, false},
+		{"010.0.0.000", 11, true},
 		{"", 0, false},
 		{"foo", 0, false},
 	}
@@ -212,3 +257,42 @@ func TestLexerScanIPv4(t *testing.T) {
 		assert.Equal(t, tc.ok, ok)
 	}
 }
+
+func TestLexerScanIPv6(t *testing.T) {
+	tests := []struct {
+		input string
+		ok    bool
+	}{
+		{"::", true},
+		{"::1", true},
+		{"2001:db8::10", true},
+		{"1:2:3:4:5:6:7:8", true},
+		{"1:2:3:4:5:6:7::", true},
+		{"::2:3:4:5:6:7:8", true},
+		{"FEDC:BA98:7654:3210:FEDC:BA98:7654:3210", true},
+		{"::ffff:192.0.2.1", true},
+		{"::13.1.68.3", true},
+		{"1::192.0.2.1", true},
+		{"1:2:3:4:5:6:192.0.2.1", true},
+		{"", false},
+		{":", false},
+		{":::", false},
+		{":::::", false},
+		{"1::2::3", false},
+		{"1:2:3:4:5:6:7", false},
+		{"1:2:3:4:5:6:7:8:9", false},
+		{"1::2:3:4:5:6:7:8", false},
+		{"12345::", false},
+		{"1:2:3:4:5:6:7:192.0.2.1", false},
+		{"1.2.3.4.5", false},
+		{"192.0.2.1", false},
+		{":::192.0.2.1", false},
+		{"::ffff:192.0.2.256", false},
+		{"::ffff:192.0.2", false},
+		{"::g", false},
+	}
+
+	for _, tc := range tests {
+		assert.Equal(t, tc.ok, isIPv6(tc.input), tc.input)
+	}
+}
@@ -1,21 +1,32 @@
-// Code generated by re2c 2.1.1 on Thu May 27 19:28:17 2021, DO NOT EDIT.
+// Code generated by re2go 4.6 on Sat Oct 17 00:23:56 2026, DO NOT EDIT.
 //line "parser.re":1
 package uri
 
 import (
-	"fmt"
+	"strings"
 )
 
-// Re2GoParse sip URI
+// Re2GoParse sip or tel URI
 func Re2GoParse(str string) (*URI, error) {
 	var cursor, marker int
 	limit := len(str)
-	var ts, te int
-	var yyt1 int
+	var ts, te, tp int
+	var ns, ne int
+	var global, isub, ext, postd, context bool
+	
+//line "parser_re.go":18
+var yyt1 int
+	var yyt2 int
+//line "parser.re":14
+
+	var reach int // furthest offset peeked, parsing fails there
 	var parseError error
 
-	err := func(msg string) { parseError = fmt.Errorf("Invalid URI '%s': %s", str, msg) }
+	err := func() { parseError = errorAt(str, reach) }
 	peek := func(str string, cursor, limit int) byte {
+		if cursor > reach {
+			reach = cursor
+		}
 		if cursor >= limit {
 			return 0
 		}
@@ -24,88 +35,133 @@ func Re2GoParse(str string) (*URI, error) {
 
 	uri := &URI{}
 	
-//line "parser_re.go":28
+//line "parser_re.go":39
 {
 	var yych byte
 	yych = peek(str, cursor, limit)
 	switch (yych) {
+	case 'T':
+		fallthrough
+	case 't':
+		goto yy3
 	case 's':
 		goto yy4
 	default:
 		if (cursor >= limit) {
-			goto yy13
+			goto yy14
 		}
-		goto yy2
+		goto yy1
 	}
-yy2:
+yy1:
 	cursor += 1
+yy2:
+//line "parser.re":95
+	{ cursor--; err(); goto fail }
+//line "parser_re.go":61
 yy3:
-//line "parser.re":66
-	{ err("invalid scheme"); goto fail }
-//line "parser_re.go":46
+	cursor += 1
+	marker = cursor
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case 'E':
+		fallthrough
+	case 'e':
+		goto yy5
+	default:
+		goto yy2
+	}
 yy4:
 	cursor += 1
 	marker = cursor
 	yych = peek(str, cursor, limit)
 	switch (yych) {
 	case 'i':
-		goto yy5
+		goto yy7
 	default:
-		goto yy3
+		goto yy2
 	}
 yy5:
 	cursor += 1
 	yych = peek(str, cursor, limit)
 	switch (yych) {
-	case 'p':
-		goto yy7
+	case 'L':
+		fallthrough
+	case 'l':
+		goto yy8
 	default:
 		goto yy6
 	}
 yy6:
 	cursor = marker
-	goto yy3
+	goto yy2
 yy7:
 	cursor += 1
 	yych = peek(str, cursor, limit)
 	switch (yych) {
-	case ':':
-		goto yy8
-	case 's':
-		goto yy10
+	case 'p':
+		goto yy9
 	default:
 		goto yy6
 	}
 yy8:
 	cursor += 1
-//line "parser.re":68
-	{ uri.scheme = SIP; goto userinfo }
-//line "parser_re.go":84
-yy10:
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case ':':
+		goto yy10
+	default:
+		goto yy6
+	}
+yy9:
 	cursor += 1
 	yych = peek(str, cursor, limit)
 	switch (yych) {
 	case ':':
 		goto yy11
+	case 's':
+		goto yy12
 	default:
 		goto yy6
 	}
+yy10:
+	cursor += 1
+//line "parser.re":99
+	{ uri.scheme = TEL; goto number }
+//line "parser_re.go":131
 yy11:
 	cursor += 1
-//line "parser.re":69
-	{ uri.scheme = SIPS; goto userinfo }
-//line "parser_re.go":98
+//line "parser.re":97
+	{ uri.scheme = SIP; goto userinfo }
+//line "parser_re.go":136
+yy12:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case ':':
+		goto yy13
+	default:
+		goto yy6
+	}
 yy13:
-//line "parser.re":67
-	{ err("invalid scheme"); goto fail }
-//line "parser_re.go":102
+	cursor += 1
+//line "parser.re":98
+	{ uri.scheme = SIPS; goto userinfo }
+//line "parser_re.go":150
+yy14:
+//line "parser.re":96
+	{ err(); goto fail }
+//line "parser_re.go":154
 }
-//line "parser.re":70
+//line "parser.re":100
 
 
 userinfo:
+	// "@" is allowed in sip URI only after userinfo
+	if strings.IndexByte(str[cursor:], '@') < 0 {
+		goto hostport
+	}
 	
-//line "parser_re.go":109
+//line "parser_re.go":165
 {
 	var yych byte
 	yych = peek(str, cursor, limit)
@@ -114,45 +170,7 @@ userinfo:
 		fallthrough
 	case '$':
 		fallthrough
-	case '&':
-		fallthrough
-	case '\'':
-		fallthrough
-	case '(':
-		fallthrough
-	case ')':
-		fallthrough
-	case '*':
-		fallthrough
-	case '+':
-		fallthrough
-	case ',':
-		fallthrough
-	case '-':
-		fallthrough
-	case '.':
-		fallthrough
-	case '/':
-		fallthrough
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
+	case '&','\'','(',')','*','+',',','-','.','/','0','1','2','3','4','5','6','7','8','9':
 		fallthrough
 	case ';':
 		fallthrough
@@ -160,111 +178,11 @@ userinfo:
 		fallthrough
 	case '?':
 		fallthrough
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
-		fallthrough
-	case 'D':
-		fallthrough
-	case 'E':
-		fallthrough
-	case 'F':
-		fallthrough
-	case 'G':
-		fallthrough
-	case 'H':
-		fallthrough
-	case 'I':
-		fallthrough
-	case 'J':
-		fallthrough
-	case 'K':
-		fallthrough
-	case 'L':
-		fallthrough
-	case 'M':
-		fallthrough
-	case 'N':
-		fallthrough
-	case 'O':
-		fallthrough
-	case 'P':
-		fallthrough
-	case 'Q':
-		fallthrough
-	case 'R':
-		fallthrough
-	case 'S':
-		fallthrough
-	case 'T':
-		fallthrough
-	case 'U':
-		fallthrough
-	case 'V':
-		fallthrough
-	case 'W':
-		fallthrough
-	case 'X':
-		fallthrough
-	case 'Y':
-		fallthrough
-	case 'Z':
+	case 'A','B','C','D','E','F','G','H','I','J','K','L','M','N','O','P','Q','R','S','T','U','V','W','X','Y','Z':
 		fallthrough
 	case '_':
 		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
-		fallthrough
-	case 'd':
-		fallthrough
-	case 'e':
-		fallthrough
-	case 'f':
-		fallthrough
-	case 'g':
-		fallthrough
-	case 'h':
-		fallthrough
-	case 'i':
-		fallthrough
-	case 'j':
-		fallthrough
-	case 'k':
-		fallthrough
-	case 'l':
-		fallthrough
-	case 'm':
-		fallthrough
-	case 'n':
-		fallthrough
-	case 'o':
-		fallthrough
-	case 'p':
-		fallthrough
-	case 'q':
-		fallthrough
-	case 'r':
-		fallthrough
-	case 's':
-		fallthrough
-	case 't':
-		fallthrough
-	case 'u':
-		fallthrough
-	case 'v':
-		fallthrough
-	case 'w':
-		fallthrough
-	case 'x':
-		fallthrough
-	case 'y':
-		fallthrough
-	case 'z':
+	case 'a','b','c','d','e','f','g','h','i','j','k','l','m','n','o','p','q','r','s','t','u','v','w','x','y','z':
 		fallthrough
 	case '~':
 		yyt1 = cursor
@@ -274,16 +192,16 @@ userinfo:
 		goto yy19
 	default:
 		if (cursor >= limit) {
-			goto yy31
+			goto yy29
 		}
 		goto yy16
 	}
 yy16:
 	cursor += 1
 yy17:
-//line "parser.re":74
-	{ cursor--; goto hostport }
-//line "parser_re.go":287
+//line "parser.re":108
+	{ cursor--; err(); goto fail }
+//line "parser_re.go":205
 yy18:
 	cursor += 1
 	marker = cursor
@@ -291,6275 +209,4470 @@ yy18:
 	switch (yych) {
 	case '!':
 		fallthrough
-	case '$':
+	case '$','%','&','\'','(',')','*','+',',','-','.','/','0','1','2','3','4','5','6','7','8','9',':',';':
 		fallthrough
-	case '%':
+	case '=':
 		fallthrough
-	case '&':
+	case '?','@','A','B','C','D','E','F','G','H','I','J','K','L','M','N','O','P','Q','R','S','T','U','V','W','X','Y','Z':
 		fallthrough
-	case '\'':
+	case '_':
 		fallthrough
-	case '(':
+	case 'a','b','c','d','e','f','g','h','i','j','k','l','m','n','o','p','q','r','s','t','u','v','w','x','y','z':
 		fallthrough
-	case ')':
+	case '~':
+		goto yy21
+	default:
+		goto yy17
+	}
+yy19:
+	cursor += 1
+	marker = cursor
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case '*':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case '+':
+	case 'a','b','c','d','e','f':
+		goto yy26
+	default:
+		goto yy17
+	}
+yy20:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+yy21:
+	switch (yych) {
+	case '!':
 		fallthrough
-	case ',':
+	case '$':
 		fallthrough
-	case '-':
+	case '&','\'','(',')','*','+',',','-','.','/','0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case '.':
+	case ';':
 		fallthrough
-	case '/':
+	case '=':
 		fallthrough
-	case '0':
+	case '?':
 		fallthrough
-	case '1':
+	case 'A','B','C','D','E','F','G','H','I','J','K','L','M','N','O','P','Q','R','S','T','U','V','W','X','Y','Z':
 		fallthrough
-	case '2':
+	case '_':
 		fallthrough
-	case '3':
+	case 'a','b','c','d','e','f','g','h','i','j','k','l','m','n','o','p','q','r','s','t','u','v','w','x','y','z':
 		fallthrough
-	case '4':
+	case '~':
+		goto yy20
+	case '%':
+		goto yy23
+	case ':':
+		goto yy24
+	case '@':
+		goto yy25
+	default:
+		goto yy22
+	}
+yy22:
+	cursor = marker
+	goto yy17
+yy23:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case '5':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case '6':
+	case 'a','b','c','d','e','f':
+		goto yy26
+	default:
+		goto yy22
+	}
+yy24:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '!':
 		fallthrough
-	case '7':
+	case '$':
 		fallthrough
-	case '8':
+	case '&','\'','(',')','*','+',',','-','.':
 		fallthrough
-	case '9':
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case ':':
+	case '=':
 		fallthrough
-	case ';':
+	case 'A','B','C','D','E','F','G','H','I','J','K','L','M','N','O','P','Q','R','S','T','U','V','W','X','Y','Z':
 		fallthrough
-	case '=':
+	case '_':
 		fallthrough
-	case '?':
+	case 'a','b','c','d','e','f','g','h','i','j','k','l','m','n','o','p','q','r','s','t','u','v','w','x','y','z':
 		fallthrough
+	case '~':
+		goto yy24
+	case '%':
+		goto yy27
 	case '@':
+		goto yy25
+	default:
+		goto yy22
+	}
+yy25:
+	cursor += 1
+	ts = yyt1
+	te = cursor
+	te += -1
+//line "parser.re":110
+	{
+		uri.userinfo = str[ts:te]
+		goto hostport
+	}
+//line "parser_re.go":331
+yy26:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'A':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'B':
+	case 'a','b','c','d','e','f':
+		goto yy20
+	default:
+		goto yy22
+	}
+yy27:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'C':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'D':
+	case 'a','b','c','d','e','f':
+		goto yy28
+	default:
+		goto yy22
+	}
+yy28:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'E':
-		fallthrough
-	case 'F':
-		fallthrough
-	case 'G':
-		fallthrough
-	case 'H':
-		fallthrough
-	case 'I':
-		fallthrough
-	case 'J':
-		fallthrough
-	case 'K':
-		fallthrough
-	case 'L':
-		fallthrough
-	case 'M':
-		fallthrough
-	case 'N':
-		fallthrough
-	case 'O':
-		fallthrough
-	case 'P':
-		fallthrough
-	case 'Q':
-		fallthrough
-	case 'R':
-		fallthrough
-	case 'S':
-		fallthrough
-	case 'T':
-		fallthrough
-	case 'U':
-		fallthrough
-	case 'V':
-		fallthrough
-	case 'W':
-		fallthrough
-	case 'X':
-		fallthrough
-	case 'Y':
-		fallthrough
-	case 'Z':
-		fallthrough
-	case '_':
-		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
-		fallthrough
-	case 'd':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'e':
-		fallthrough
-	case 'f':
-		fallthrough
-	case 'g':
-		fallthrough
-	case 'h':
-		fallthrough
-	case 'i':
-		fallthrough
-	case 'j':
-		fallthrough
-	case 'k':
-		fallthrough
-	case 'l':
-		fallthrough
-	case 'm':
-		fallthrough
-	case 'n':
-		fallthrough
-	case 'o':
-		fallthrough
-	case 'p':
-		fallthrough
-	case 'q':
-		fallthrough
-	case 'r':
-		fallthrough
-	case 's':
-		fallthrough
-	case 't':
-		fallthrough
-	case 'u':
-		fallthrough
-	case 'v':
-		fallthrough
-	case 'w':
-		fallthrough
-	case 'x':
-		fallthrough
-	case 'y':
-		fallthrough
-	case 'z':
+	case 'a','b','c','d','e','f':
+		goto yy24
+	default:
+		goto yy22
+	}
+yy29:
+//line "parser.re":109
+	{ err(); goto fail }
+//line "parser_re.go":374
+}
+//line "parser.re":114
+
+hostport:
+	
+//line "parser_re.go":380
+{
+	var yych byte
+	yyaccept := 0
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1':
+		yyt1 = cursor
+		goto yy33
+	case '2':
+		yyt1 = cursor
+		goto yy34
+	case '3','4','5','6','7','8','9':
+		yyt1 = cursor
+		goto yy35
+	case 'A','B','C','D','E','F','G','H','I','J','K','L','M','N','O','P','Q','R','S','T','U','V','W','X','Y','Z':
 		fallthrough
-	case '~':
-		goto yy21
+	case 'a','b','c','d','e','f','g','h','i','j','k','l','m','n','o','p','q','r','s','t','u','v','w','x','y','z':
+		yyt1 = cursor
+		goto yy36
+	case '[':
+		yyt1 = cursor
+		goto yy38
 	default:
-		goto yy17
+		if (cursor >= limit) {
+			goto yy214
+		}
+		goto yy31
 	}
-yy19:
+yy31:
+	cursor += 1
+yy32:
+//line "parser.re":117
+	{ cursor--; err(); goto fail }
+//line "parser_re.go":414
+yy33:
+	yyaccept = 0
 	cursor += 1
 	marker = cursor
 	yych = peek(str, cursor, limit)
 	switch (yych) {
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
+	case '-':
+		goto yy39
+	case '.':
+		goto yy42
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy43
+	case 'A','B','C','D','E','F','G','H','I','J','K','L','M','N','O','P','Q','R','S','T','U','V','W','X','Y','Z':
 		fallthrough
+	case 'a','b','c','d','e','f','g','h','i','j','k','l','m','n','o','p','q','r','s','t','u','v','w','x','y','z':
+		goto yy44
+	default:
+		goto yy32
+	}
+yy34:
+	yyaccept = 0
+	cursor += 1
+	marker = cursor
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '-':
+		goto yy39
+	case '.':
+		goto yy42
+	case '0','1','2','3','4':
+		goto yy43
 	case '5':
+		goto yy46
+	case '6','7','8','9':
+		goto yy47
+	case 'A','B','C','D','E','F','G','H','I','J','K','L','M','N','O','P','Q','R','S','T','U','V','W','X','Y','Z':
 		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		fallthrough
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
-		fallthrough
-	case 'D':
-		fallthrough
-	case 'E':
-		fallthrough
-	case 'F':
-		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
-		fallthrough
-	case 'd':
-		fallthrough
-	case 'e':
-		fallthrough
-	case 'f':
-		goto yy28
+	case 'a','b','c','d','e','f','g','h','i','j','k','l','m','n','o','p','q','r','s','t','u','v','w','x','y','z':
+		goto yy44
 	default:
-		goto yy17
+		goto yy32
 	}
-yy20:
+yy35:
+	yyaccept = 0
 	cursor += 1
+	marker = cursor
 	yych = peek(str, cursor, limit)
-yy21:
 	switch (yych) {
-	case '!':
-		fallthrough
-	case '$':
-		fallthrough
-	case '&':
-		fallthrough
-	case '\'':
-		fallthrough
-	case '(':
-		fallthrough
-	case ')':
-		fallthrough
-	case '*':
-		fallthrough
-	case '+':
-		fallthrough
-	case ',':
-		fallthrough
 	case '-':
-		fallthrough
+		goto yy39
 	case '.':
+		goto yy42
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy47
+	case 'A','B','C','D','E','F','G','H','I','J','K','L','M','N','O','P','Q','R','S','T','U','V','W','X','Y','Z':
 		fallthrough
-	case '/':
-		fallthrough
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
+	case 'a','b','c','d','e','f','g','h','i','j','k','l','m','n','o','p','q','r','s','t','u','v','w','x','y','z':
+		goto yy44
+	default:
+		goto yy32
+	}
+yy36:
+	yyaccept = 1
+	cursor += 1
+	marker = cursor
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '-':
+		goto yy48
+	case '.':
+		goto yy49
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case '3':
+	case 'A','B','C','D','E','F','G','H','I','J','K','L','M','N','O','P','Q','R','S','T','U','V','W','X','Y','Z':
 		fallthrough
-	case '4':
+	case 'a','b','c','d','e','f','g','h','i','j','k','l','m','n','o','p','q','r','s','t','u','v','w','x','y','z':
+		goto yy36
+	case ':':
+		goto yy50
+	default:
+		yyt2 = -1
+		goto yy37
+	}
+yy37:
+	ts = yyt1
+	tp = yyt2
+	te = cursor
+//line "parser.re":119
+	{
+		if tp >= 0 {
+			if port, _, _ := dtoi(str[tp:te]); port > 0xFFFF {
+				reach = tp
+				err()
+				goto fail
+			}
+		}
+		uri.hostport = str[ts:te]
+		ts = cursor + 1
+		goto params
+	}
+//line "parser_re.go":515
+yy38:
+	yyaccept = 0
+	cursor += 1
+	marker = cursor
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case '5':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case '6':
+	case 'a','b','c','d','e','f':
+		goto yy51
+	case ':':
+		goto yy52
+	default:
+		goto yy32
+	}
+yy39:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+yy40:
+	switch (yych) {
+	case '-':
+		goto yy39
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case '7':
+	case 'A','B','C','D','E','F','G','H','I','J','K','L','M','N','O','P','Q','R','S','T','U','V','W','X','Y','Z':
 		fallthrough
-	case '8':
+	case 'a','b','c','d','e','f','g','h','i','j','k','l','m','n','o','p','q','r','s','t','u','v','w','x','y','z':
+		goto yy44
+	default:
+		goto yy41
+	}
+yy41:
+	cursor = marker
+	if (yyaccept == 0) {
+		goto yy32
+	} else {
+		yyt2 = -1
+		goto yy37
+	}
+yy42:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1':
+		goto yy53
+	case '2':
+		goto yy54
+	case '3','4','5','6','7','8','9':
+		goto yy55
+	case 'A','B','C','D','E','F','G','H','I','J','K','L','M','N','O','P','Q','R','S','T','U','V','W','X','Y','Z':
 		fallthrough
-	case '9':
+	case 'a','b','c','d','e','f','g','h','i','j','k','l','m','n','o','p','q','r','s','t','u','v','w','x','y','z':
+		goto yy36
+	default:
+		goto yy41
+	}
+yy43:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case 0x00:
+		goto yy41
+	case '.':
+		goto yy42
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy47
+	default:
+		goto yy40
+	}
+yy44:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+yy45:
+	switch (yych) {
+	case '-':
+		goto yy39
+	case '.':
+		goto yy56
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case ';':
+	case 'A','B','C','D','E','F','G','H','I','J','K','L','M','N','O','P','Q','R','S','T','U','V','W','X','Y','Z':
 		fallthrough
-	case '=':
-		fallthrough
-	case '?':
-		fallthrough
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
-		fallthrough
-	case 'D':
-		fallthrough
-	case 'E':
-		fallthrough
-	case 'F':
-		fallthrough
-	case 'G':
-		fallthrough
-	case 'H':
-		fallthrough
-	case 'I':
-		fallthrough
-	case 'J':
-		fallthrough
-	case 'K':
-		fallthrough
-	case 'L':
-		fallthrough
-	case 'M':
-		fallthrough
-	case 'N':
-		fallthrough
-	case 'O':
-		fallthrough
-	case 'P':
-		fallthrough
-	case 'Q':
-		fallthrough
-	case 'R':
-		fallthrough
-	case 'S':
-		fallthrough
-	case 'T':
-		fallthrough
-	case 'U':
-		fallthrough
-	case 'V':
-		fallthrough
-	case 'W':
-		fallthrough
-	case 'X':
-		fallthrough
-	case 'Y':
-		fallthrough
-	case 'Z':
-		fallthrough
-	case '_':
-		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
-		fallthrough
-	case 'd':
-		fallthrough
-	case 'e':
-		fallthrough
-	case 'f':
-		fallthrough
-	case 'g':
-		fallthrough
-	case 'h':
-		fallthrough
-	case 'i':
-		fallthrough
-	case 'j':
-		fallthrough
-	case 'k':
-		fallthrough
-	case 'l':
-		fallthrough
-	case 'm':
-		fallthrough
-	case 'n':
-		fallthrough
-	case 'o':
-		fallthrough
-	case 'p':
-		fallthrough
-	case 'q':
-		fallthrough
-	case 'r':
-		fallthrough
-	case 's':
-		fallthrough
-	case 't':
-		fallthrough
-	case 'u':
-		fallthrough
-	case 'v':
+	case 'a','b','c','d','e','f','g','h','i','j','k','l','m','n','o','p','q','r','s','t','u','v','w','x','y','z':
+		goto yy44
+	default:
+		goto yy41
+	}
+yy46:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case 0x00:
+		goto yy41
+	case '.':
+		goto yy42
+	case '0','1','2','3','4','5':
+		goto yy47
+	default:
+		goto yy40
+	}
+yy47:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case 0x00:
+		goto yy41
+	case '.':
+		goto yy42
+	default:
+		goto yy40
+	}
+yy48:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '-':
+		goto yy48
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'w':
+	case 'A','B','C','D','E','F','G','H','I','J','K','L','M','N','O','P','Q','R','S','T','U','V','W','X','Y','Z':
 		fallthrough
-	case 'x':
+	case 'a','b','c','d','e','f','g','h','i','j','k','l','m','n','o','p','q','r','s','t','u','v','w','x','y','z':
+		goto yy36
+	default:
+		goto yy41
+	}
+yy49:
+	yyaccept = 1
+	cursor += 1
+	marker = cursor
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy44
+	case ':':
+		goto yy50
+	case 'A','B','C','D','E','F','G','H','I','J','K','L','M','N','O','P','Q','R','S','T','U','V','W','X','Y','Z':
 		fallthrough
-	case 'y':
+	case 'a','b','c','d','e','f','g','h','i','j','k','l','m','n','o','p','q','r','s','t','u','v','w','x','y','z':
+		goto yy36
+	default:
+		yyt2 = -1
+		goto yy37
+	}
+yy50:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
+		yyt2 = cursor
+		goto yy57
+	default:
+		goto yy41
+	}
+yy51:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'z':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case '~':
-		goto yy20
-	case '%':
-		goto yy23
+	case 'a','b','c','d','e','f':
+		goto yy58
 	case ':':
-		goto yy24
-	case '@':
-		goto yy26
+		goto yy59
 	default:
-		goto yy22
+		goto yy41
 	}
-yy22:
-	cursor = marker
-	goto yy17
-yy23:
+yy52:
 	cursor += 1
 	yych = peek(str, cursor, limit)
 	switch (yych) {
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
+	case ':':
+		goto yy60
+	default:
+		goto yy41
+	}
+yy53:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case 0x00:
+		goto yy41
+	case '.':
+		goto yy61
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy55
+	default:
+		goto yy40
+	}
+yy54:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case 0x00:
+		goto yy41
+	case '.':
+		goto yy61
+	case '0','1','2','3','4':
+		goto yy55
 	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		fallthrough
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
-		fallthrough
-	case 'D':
-		fallthrough
-	case 'E':
-		fallthrough
-	case 'F':
-		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
-		fallthrough
-	case 'd':
-		fallthrough
-	case 'e':
-		fallthrough
-	case 'f':
-		goto yy28
+		goto yy62
+	case '6','7','8','9':
+		goto yy63
 	default:
-		goto yy22
+		goto yy40
 	}
-yy24:
+yy55:
 	cursor += 1
 	yych = peek(str, cursor, limit)
 	switch (yych) {
-	case '!':
-		fallthrough
-	case '$':
-		fallthrough
-	case '&':
-		fallthrough
-	case '\'':
-		fallthrough
-	case '(':
-		fallthrough
-	case ')':
-		fallthrough
-	case '*':
-		fallthrough
-	case '+':
-		fallthrough
-	case ',':
-		fallthrough
-	case '-':
-		fallthrough
+	case 0x00:
+		goto yy41
 	case '.':
+		goto yy61
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy63
+	default:
+		goto yy40
+	}
+yy56:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy44
+	case 'A','B','C','D','E','F','G','H','I','J','K','L','M','N','O','P','Q','R','S','T','U','V','W','X','Y','Z':
 		fallthrough
-	case '0':
-		fallthrough
-	case '1':
+	case 'a','b','c','d','e','f','g','h','i','j','k','l','m','n','o','p','q','r','s','t','u','v','w','x','y','z':
+		goto yy36
+	default:
+		goto yy41
+	}
+yy57:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy57
+	default:
+		goto yy37
+	}
+yy58:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case '2':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case '3':
+	case 'a','b','c','d','e','f':
+		goto yy64
+	case ':':
+		goto yy59
+	default:
+		goto yy41
+	}
+yy59:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case '4':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		fallthrough
-	case '=':
-		fallthrough
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
-		fallthrough
-	case 'D':
-		fallthrough
-	case 'E':
-		fallthrough
-	case 'F':
-		fallthrough
-	case 'G':
-		fallthrough
-	case 'H':
-		fallthrough
-	case 'I':
-		fallthrough
-	case 'J':
-		fallthrough
-	case 'K':
-		fallthrough
-	case 'L':
-		fallthrough
-	case 'M':
-		fallthrough
-	case 'N':
-		fallthrough
-	case 'O':
-		fallthrough
-	case 'P':
-		fallthrough
-	case 'Q':
-		fallthrough
-	case 'R':
-		fallthrough
-	case 'S':
-		fallthrough
-	case 'T':
-		fallthrough
-	case 'U':
-		fallthrough
-	case 'V':
-		fallthrough
-	case 'W':
-		fallthrough
-	case 'X':
-		fallthrough
-	case 'Y':
-		fallthrough
-	case 'Z':
-		fallthrough
-	case '_':
-		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
-		fallthrough
-	case 'd':
-		fallthrough
-	case 'e':
-		fallthrough
-	case 'f':
-		fallthrough
-	case 'g':
-		fallthrough
-	case 'h':
-		fallthrough
-	case 'i':
-		fallthrough
-	case 'j':
-		fallthrough
-	case 'k':
-		fallthrough
-	case 'l':
-		fallthrough
-	case 'm':
-		fallthrough
-	case 'n':
-		fallthrough
-	case 'o':
-		fallthrough
-	case 'p':
-		fallthrough
-	case 'q':
-		fallthrough
-	case 'r':
-		fallthrough
-	case 's':
-		fallthrough
-	case 't':
-		fallthrough
-	case 'u':
-		fallthrough
-	case 'v':
-		fallthrough
-	case 'w':
-		fallthrough
-	case 'x':
-		fallthrough
-	case 'y':
-		fallthrough
-	case 'z':
-		fallthrough
-	case '~':
-		goto yy24
-	case '%':
-		goto yy29
-	case '@':
-		goto yy26
+	case 'a','b','c','d','e','f':
+		goto yy65
+	case ':':
+		goto yy66
 	default:
-		goto yy22
+		goto yy41
 	}
-yy26:
+yy60:
 	cursor += 1
-	ts = yyt1
-	te = cursor
-	te += -1
-//line "parser.re":76
-	{
-		uri.userinfo = str[ts:te]
-		goto hostport
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1':
+		goto yy67
+	case '2':
+		goto yy68
+	case '3','4','5','6','7','8','9':
+		goto yy69
+	case 'A','B','C','D','E','F':
+		fallthrough
+	case 'a','b','c','d','e','f':
+		goto yy70
+	case ']':
+		goto yy71
+	default:
+		goto yy41
 	}
-//line "parser_re.go":911
-yy28:
+yy61:
 	cursor += 1
 	yych = peek(str, cursor, limit)
 	switch (yych) {
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
+	case '0','1':
+		goto yy72
 	case '2':
+		goto yy73
+	case '3','4','5','6','7','8','9':
+		goto yy74
+	case 'A','B','C','D','E','F','G','H','I','J','K','L','M','N','O','P','Q','R','S','T','U','V','W','X','Y','Z':
 		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		fallthrough
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
-		fallthrough
-	case 'D':
-		fallthrough
-	case 'E':
-		fallthrough
-	case 'F':
-		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
-		fallthrough
-	case 'd':
+	case 'a','b','c','d','e','f','g','h','i','j','k','l','m','n','o','p','q','r','s','t','u','v','w','x','y','z':
+		goto yy36
+	default:
+		goto yy41
+	}
+yy62:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case 0x00:
+		goto yy41
+	case '.':
+		goto yy61
+	case '0','1','2','3','4','5':
+		goto yy63
+	default:
+		goto yy40
+	}
+yy63:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case 0x00:
+		goto yy41
+	case '.':
+		goto yy61
+	default:
+		goto yy40
+	}
+yy64:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'e':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'f':
-		goto yy20
+	case 'a','b','c','d','e','f':
+		goto yy75
+	case ':':
+		goto yy59
 	default:
-		goto yy22
+		goto yy41
 	}
-yy29:
+yy65:
 	cursor += 1
 	yych = peek(str, cursor, limit)
 	switch (yych) {
-	case '0':
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case '1':
+	case 'A','B','C','D','E','F':
 		fallthrough
+	case 'a','b','c','d','e','f':
+		goto yy76
+	case ':':
+		goto yy77
+	default:
+		goto yy41
+	}
+yy66:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1':
+		goto yy78
 	case '2':
+		goto yy79
+	case '3','4','5','6','7','8','9':
+		goto yy80
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case '3':
-		fallthrough
-	case '4':
+	case 'a','b','c','d','e','f':
+		goto yy81
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy67:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy82
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy83
+	case ':':
+		goto yy84
+	case 'A','B','C','D','E','F':
 		fallthrough
+	case 'a','b','c','d','e','f':
+		goto yy85
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy68:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy82
+	case '0','1','2','3','4':
+		goto yy83
 	case '5':
+		goto yy86
+	case '6','7','8','9':
+		goto yy87
+	case ':':
+		goto yy84
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		fallthrough
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
-		fallthrough
-	case 'D':
-		fallthrough
-	case 'E':
-		fallthrough
-	case 'F':
-		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
-		fallthrough
-	case 'd':
-		fallthrough
-	case 'e':
-		fallthrough
-	case 'f':
-		goto yy30
+	case 'a','b','c','d','e','f':
+		goto yy85
+	case ']':
+		goto yy71
 	default:
-		goto yy22
+		goto yy41
 	}
-yy30:
+yy69:
 	cursor += 1
 	yych = peek(str, cursor, limit)
 	switch (yych) {
-	case '0':
+	case '.':
+		goto yy82
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy87
+	case ':':
+		goto yy84
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case '1':
+	case 'a','b','c','d','e','f':
+		goto yy85
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy70:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case '2':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		fallthrough
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
-		fallthrough
-	case 'D':
-		fallthrough
-	case 'E':
-		fallthrough
-	case 'F':
-		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
-		fallthrough
-	case 'd':
-		fallthrough
-	case 'e':
-		fallthrough
-	case 'f':
-		goto yy24
+	case 'a','b','c','d','e','f':
+		goto yy85
+	case ':':
+		goto yy84
+	case ']':
+		goto yy71
 	default:
-		goto yy22
+		goto yy41
 	}
-yy31:
-//line "parser.re":75
-	{ err("invalid userinfo"); goto fail }
-//line "parser_re.go":1068
-}
-//line "parser.re":80
-
-hostport:
-	
-//line "parser_re.go":1074
-{
-	var yych byte
-	yyaccept := 0
+yy71:
+	yyaccept = 1
+	cursor += 1
+	marker = cursor
 	yych = peek(str, cursor, limit)
 	switch (yych) {
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		yyt1 = cursor
-		goto yy36
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
-		fallthrough
-	case 'D':
-		fallthrough
-	case 'E':
-		fallthrough
-	case 'F':
-		fallthrough
-	case 'G':
-		fallthrough
-	case 'H':
-		fallthrough
-	case 'I':
-		fallthrough
-	case 'J':
-		fallthrough
-	case 'K':
-		fallthrough
-	case 'L':
-		fallthrough
-	case 'M':
-		fallthrough
-	case 'N':
-		fallthrough
-	case 'O':
-		fallthrough
-	case 'P':
-		fallthrough
-	case 'Q':
-		fallthrough
-	case 'R':
-		fallthrough
-	case 'S':
-		fallthrough
-	case 'T':
-		fallthrough
-	case 'U':
-		fallthrough
-	case 'V':
-		fallthrough
-	case 'W':
-		fallthrough
-	case 'X':
-		fallthrough
-	case 'Y':
-		fallthrough
-	case 'Z':
-		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
-		fallthrough
-	case 'd':
-		fallthrough
-	case 'e':
-		fallthrough
-	case 'f':
-		fallthrough
-	case 'g':
-		fallthrough
-	case 'h':
-		fallthrough
-	case 'i':
-		fallthrough
-	case 'j':
-		fallthrough
-	case 'k':
-		fallthrough
-	case 'l':
-		fallthrough
-	case 'm':
-		fallthrough
-	case 'n':
-		fallthrough
-	case 'o':
-		fallthrough
-	case 'p':
-		fallthrough
-	case 'q':
-		fallthrough
-	case 'r':
-		fallthrough
-	case 's':
-		fallthrough
-	case 't':
-		fallthrough
-	case 'u':
-		fallthrough
-	case 'v':
-		fallthrough
-	case 'w':
-		fallthrough
-	case 'x':
-		fallthrough
-	case 'y':
-		fallthrough
-	case 'z':
-		yyt1 = cursor
-		goto yy37
-	case '[':
-		yyt1 = cursor
-		goto yy40
+	case ':':
+		goto yy50
 	default:
-		if (cursor >= limit) {
-			goto yy102
-		}
-		goto yy34
+		yyt2 = -1
+		goto yy37
 	}
-yy34:
-	cursor += 1
-yy35:
-//line "parser.re":83
-	{ err("invalid host or port"); goto fail }
-//line "parser_re.go":1220
-yy36:
-	yyaccept = 0
+yy72:
 	cursor += 1
-	marker = cursor
 	yych = peek(str, cursor, limit)
 	switch (yych) {
-	case '-':
+	case 0x00:
 		goto yy41
 	case '.':
-		goto yy44
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		goto yy45
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
-		fallthrough
-	case 'D':
-		fallthrough
-	case 'E':
-		fallthrough
-	case 'F':
-		fallthrough
-	case 'G':
-		fallthrough
-	case 'H':
-		fallthrough
-	case 'I':
-		fallthrough
-	case 'J':
-		fallthrough
-	case 'K':
-		fallthrough
-	case 'L':
-		fallthrough
-	case 'M':
-		fallthrough
-	case 'N':
-		fallthrough
-	case 'O':
-		fallthrough
-	case 'P':
-		fallthrough
-	case 'Q':
-		fallthrough
-	case 'R':
-		fallthrough
-	case 'S':
-		fallthrough
-	case 'T':
-		fallthrough
-	case 'U':
-		fallthrough
-	case 'V':
-		fallthrough
-	case 'W':
-		fallthrough
-	case 'X':
-		fallthrough
-	case 'Y':
-		fallthrough
-	case 'Z':
-		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
-		fallthrough
-	case 'd':
-		fallthrough
-	case 'e':
-		fallthrough
-	case 'f':
-		fallthrough
-	case 'g':
-		fallthrough
-	case 'h':
-		fallthrough
-	case 'i':
-		fallthrough
-	case 'j':
-		fallthrough
-	case 'k':
-		fallthrough
-	case 'l':
-		fallthrough
-	case 'm':
-		fallthrough
-	case 'n':
-		fallthrough
-	case 'o':
-		fallthrough
-	case 'p':
-		fallthrough
-	case 'q':
-		fallthrough
-	case 'r':
-		fallthrough
-	case 's':
-		fallthrough
-	case 't':
-		fallthrough
-	case 'u':
-		fallthrough
-	case 'v':
-		fallthrough
-	case 'w':
-		fallthrough
-	case 'x':
-		fallthrough
-	case 'y':
-		fallthrough
-	case 'z':
-		goto yy46
+		goto yy88
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy74
 	default:
-		goto yy35
+		goto yy40
 	}
-yy37:
-	yyaccept = 1
+yy73:
 	cursor += 1
-	marker = cursor
 	yych = peek(str, cursor, limit)
 	switch (yych) {
-	case '-':
-		goto yy48
+	case 0x00:
+		goto yy41
 	case '.':
-		goto yy50
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
+		goto yy88
+	case '0','1','2','3','4':
+		goto yy74
 	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		fallthrough
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
-		fallthrough
-	case 'D':
-		fallthrough
-	case 'E':
-		fallthrough
-	case 'F':
-		fallthrough
-	case 'G':
-		fallthrough
-	case 'H':
-		fallthrough
-	case 'I':
-		fallthrough
-	case 'J':
-		fallthrough
-	case 'K':
-		fallthrough
-	case 'L':
-		fallthrough
-	case 'M':
-		fallthrough
-	case 'N':
-		fallthrough
-	case 'O':
-		fallthrough
-	case 'P':
-		fallthrough
-	case 'Q':
-		fallthrough
-	case 'R':
-		fallthrough
-	case 'S':
-		fallthrough
-	case 'T':
-		fallthrough
-	case 'U':
-		fallthrough
-	case 'V':
-		fallthrough
-	case 'W':
-		fallthrough
-	case 'X':
-		fallthrough
-	case 'Y':
-		fallthrough
-	case 'Z':
-		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
-		fallthrough
-	case 'd':
-		fallthrough
-	case 'e':
-		fallthrough
-	case 'f':
-		fallthrough
-	case 'g':
-		fallthrough
-	case 'h':
-		fallthrough
-	case 'i':
-		fallthrough
-	case 'j':
-		fallthrough
-	case 'k':
-		fallthrough
-	case 'l':
-		fallthrough
-	case 'm':
-		fallthrough
-	case 'n':
-		fallthrough
-	case 'o':
-		fallthrough
-	case 'p':
-		fallthrough
-	case 'q':
-		fallthrough
-	case 'r':
-		fallthrough
-	case 's':
-		fallthrough
-	case 't':
-		fallthrough
-	case 'u':
-		fallthrough
-	case 'v':
-		fallthrough
-	case 'w':
-		fallthrough
-	case 'x':
-		fallthrough
-	case 'y':
-		fallthrough
-	case 'z':
-		goto yy37
-	case ':':
-		goto yy51
-	default:
-		goto yy39
-	}
-yy39:
-	ts = yyt1
-	te = cursor
-//line "parser.re":85
-	{
-		uri.hostport = str[ts:te]
-		goto params
-	}
-//line "parser_re.go":1505
-yy40:
-	yyaccept = 0
-	cursor += 1
-	marker = cursor
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		fallthrough
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
-		fallthrough
-	case 'D':
-		fallthrough
-	case 'E':
-		fallthrough
-	case 'F':
-		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
-		fallthrough
-	case 'd':
-		fallthrough
-	case 'e':
-		fallthrough
-	case 'f':
-		goto yy52
-	case ':':
-		goto yy53
-	default:
-		goto yy35
-	}
-yy41:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-yy42:
-	switch (yych) {
-	case '-':
-		goto yy41
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		fallthrough
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
-		fallthrough
-	case 'D':
-		fallthrough
-	case 'E':
-		fallthrough
-	case 'F':
-		fallthrough
-	case 'G':
-		fallthrough
-	case 'H':
-		fallthrough
-	case 'I':
-		fallthrough
-	case 'J':
-		fallthrough
-	case 'K':
-		fallthrough
-	case 'L':
-		fallthrough
-	case 'M':
-		fallthrough
-	case 'N':
-		fallthrough
-	case 'O':
-		fallthrough
-	case 'P':
-		fallthrough
-	case 'Q':
-		fallthrough
-	case 'R':
-		fallthrough
-	case 'S':
-		fallthrough
-	case 'T':
-		fallthrough
-	case 'U':
-		fallthrough
-	case 'V':
-		fallthrough
-	case 'W':
-		fallthrough
-	case 'X':
-		fallthrough
-	case 'Y':
-		fallthrough
-	case 'Z':
-		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
-		fallthrough
-	case 'd':
-		fallthrough
-	case 'e':
-		fallthrough
-	case 'f':
-		fallthrough
-	case 'g':
-		fallthrough
-	case 'h':
-		fallthrough
-	case 'i':
-		fallthrough
-	case 'j':
-		fallthrough
-	case 'k':
-		fallthrough
-	case 'l':
-		fallthrough
-	case 'm':
-		fallthrough
-	case 'n':
-		fallthrough
-	case 'o':
-		fallthrough
-	case 'p':
-		fallthrough
-	case 'q':
-		fallthrough
-	case 'r':
-		fallthrough
-	case 's':
-		fallthrough
-	case 't':
-		fallthrough
-	case 'u':
-		fallthrough
-	case 'v':
-		fallthrough
-	case 'w':
-		fallthrough
-	case 'x':
-		fallthrough
-	case 'y':
-		fallthrough
-	case 'z':
-		goto yy46
-	default:
-		goto yy43
-	}
-yy43:
-	cursor = marker
-	if (yyaccept == 0) {
-		goto yy35
-	} else {
-		goto yy39
-	}
-yy44:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		goto yy54
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
-		fallthrough
-	case 'D':
-		fallthrough
-	case 'E':
-		fallthrough
-	case 'F':
-		fallthrough
-	case 'G':
-		fallthrough
-	case 'H':
-		fallthrough
-	case 'I':
-		fallthrough
-	case 'J':
-		fallthrough
-	case 'K':
-		fallthrough
-	case 'L':
-		fallthrough
-	case 'M':
-		fallthrough
-	case 'N':
-		fallthrough
-	case 'O':
-		fallthrough
-	case 'P':
-		fallthrough
-	case 'Q':
-		fallthrough
-	case 'R':
-		fallthrough
-	case 'S':
-		fallthrough
-	case 'T':
-		fallthrough
-	case 'U':
-		fallthrough
-	case 'V':
-		fallthrough
-	case 'W':
-		fallthrough
-	case 'X':
-		fallthrough
-	case 'Y':
-		fallthrough
-	case 'Z':
-		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
-		fallthrough
-	case 'd':
-		fallthrough
-	case 'e':
-		fallthrough
-	case 'f':
-		fallthrough
-	case 'g':
-		fallthrough
-	case 'h':
-		fallthrough
-	case 'i':
-		fallthrough
-	case 'j':
-		fallthrough
-	case 'k':
-		fallthrough
-	case 'l':
-		fallthrough
-	case 'm':
-		fallthrough
-	case 'n':
-		fallthrough
-	case 'o':
-		fallthrough
-	case 'p':
-		fallthrough
-	case 'q':
-		fallthrough
-	case 'r':
-		fallthrough
-	case 's':
-		fallthrough
-	case 't':
-		fallthrough
-	case 'u':
-		fallthrough
-	case 'v':
-		fallthrough
-	case 'w':
-		fallthrough
-	case 'x':
-		fallthrough
-	case 'y':
-		fallthrough
-	case 'z':
-		goto yy37
-	default:
-		goto yy43
-	}
-yy45:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case 0x00:
-		goto yy43
-	case '.':
-		goto yy44
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		goto yy55
-	default:
-		goto yy42
-	}
-yy46:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-yy47:
-	switch (yych) {
-	case '-':
-		goto yy41
-	case '.':
-		goto yy56
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		fallthrough
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
-		fallthrough
-	case 'D':
-		fallthrough
-	case 'E':
-		fallthrough
-	case 'F':
-		fallthrough
-	case 'G':
-		fallthrough
-	case 'H':
-		fallthrough
-	case 'I':
-		fallthrough
-	case 'J':
-		fallthrough
-	case 'K':
-		fallthrough
-	case 'L':
-		fallthrough
-	case 'M':
-		fallthrough
-	case 'N':
-		fallthrough
-	case 'O':
-		fallthrough
-	case 'P':
-		fallthrough
-	case 'Q':
-		fallthrough
-	case 'R':
-		fallthrough
-	case 'S':
-		fallthrough
-	case 'T':
-		fallthrough
-	case 'U':
-		fallthrough
-	case 'V':
-		fallthrough
-	case 'W':
-		fallthrough
-	case 'X':
-		fallthrough
-	case 'Y':
-		fallthrough
-	case 'Z':
-		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
-		fallthrough
-	case 'd':
-		fallthrough
-	case 'e':
-		fallthrough
-	case 'f':
-		fallthrough
-	case 'g':
-		fallthrough
-	case 'h':
-		fallthrough
-	case 'i':
-		fallthrough
-	case 'j':
-		fallthrough
-	case 'k':
-		fallthrough
-	case 'l':
-		fallthrough
-	case 'm':
-		fallthrough
-	case 'n':
-		fallthrough
-	case 'o':
-		fallthrough
-	case 'p':
-		fallthrough
-	case 'q':
-		fallthrough
-	case 'r':
-		fallthrough
-	case 's':
-		fallthrough
-	case 't':
-		fallthrough
-	case 'u':
-		fallthrough
-	case 'v':
-		fallthrough
-	case 'w':
-		fallthrough
-	case 'x':
-		fallthrough
-	case 'y':
-		fallthrough
-	case 'z':
-		goto yy46
-	default:
-		goto yy43
-	}
-yy48:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case '-':
-		goto yy48
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		fallthrough
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
-		fallthrough
-	case 'D':
-		fallthrough
-	case 'E':
-		fallthrough
-	case 'F':
-		fallthrough
-	case 'G':
-		fallthrough
-	case 'H':
-		fallthrough
-	case 'I':
-		fallthrough
-	case 'J':
-		fallthrough
-	case 'K':
-		fallthrough
-	case 'L':
-		fallthrough
-	case 'M':
-		fallthrough
-	case 'N':
-		fallthrough
-	case 'O':
-		fallthrough
-	case 'P':
-		fallthrough
-	case 'Q':
-		fallthrough
-	case 'R':
-		fallthrough
-	case 'S':
-		fallthrough
-	case 'T':
-		fallthrough
-	case 'U':
-		fallthrough
-	case 'V':
-		fallthrough
-	case 'W':
-		fallthrough
-	case 'X':
-		fallthrough
-	case 'Y':
-		fallthrough
-	case 'Z':
-		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
-		fallthrough
-	case 'd':
-		fallthrough
-	case 'e':
-		fallthrough
-	case 'f':
-		fallthrough
-	case 'g':
-		fallthrough
-	case 'h':
-		fallthrough
-	case 'i':
-		fallthrough
-	case 'j':
-		fallthrough
-	case 'k':
-		fallthrough
-	case 'l':
-		fallthrough
-	case 'm':
-		fallthrough
-	case 'n':
-		fallthrough
-	case 'o':
-		fallthrough
-	case 'p':
-		fallthrough
-	case 'q':
-		fallthrough
-	case 'r':
-		fallthrough
-	case 's':
-		fallthrough
-	case 't':
-		fallthrough
-	case 'u':
-		fallthrough
-	case 'v':
-		fallthrough
-	case 'w':
-		fallthrough
-	case 'x':
-		fallthrough
-	case 'y':
-		fallthrough
-	case 'z':
-		goto yy37
-	default:
-		goto yy43
-	}
-yy50:
-	yyaccept = 1
-	cursor += 1
-	marker = cursor
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		goto yy46
-	case ':':
-		goto yy51
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
-		fallthrough
-	case 'D':
-		fallthrough
-	case 'E':
-		fallthrough
-	case 'F':
-		fallthrough
-	case 'G':
-		fallthrough
-	case 'H':
-		fallthrough
-	case 'I':
-		fallthrough
-	case 'J':
-		fallthrough
-	case 'K':
-		fallthrough
-	case 'L':
-		fallthrough
-	case 'M':
-		fallthrough
-	case 'N':
-		fallthrough
-	case 'O':
-		fallthrough
-	case 'P':
-		fallthrough
-	case 'Q':
-		fallthrough
-	case 'R':
-		fallthrough
-	case 'S':
-		fallthrough
-	case 'T':
-		fallthrough
-	case 'U':
-		fallthrough
-	case 'V':
-		fallthrough
-	case 'W':
-		fallthrough
-	case 'X':
-		fallthrough
-	case 'Y':
-		fallthrough
-	case 'Z':
-		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
-		fallthrough
-	case 'd':
-		fallthrough
-	case 'e':
-		fallthrough
-	case 'f':
-		fallthrough
-	case 'g':
-		fallthrough
-	case 'h':
-		fallthrough
-	case 'i':
-		fallthrough
-	case 'j':
-		fallthrough
-	case 'k':
-		fallthrough
-	case 'l':
-		fallthrough
-	case 'm':
-		fallthrough
-	case 'n':
-		fallthrough
-	case 'o':
-		fallthrough
-	case 'p':
-		fallthrough
-	case 'q':
-		fallthrough
-	case 'r':
-		fallthrough
-	case 's':
-		fallthrough
-	case 't':
-		fallthrough
-	case 'u':
-		fallthrough
-	case 'v':
-		fallthrough
-	case 'w':
-		fallthrough
-	case 'x':
-		fallthrough
-	case 'y':
-		fallthrough
-	case 'z':
-		goto yy37
-	default:
-		goto yy39
-	}
-yy51:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		goto yy57
-	default:
-		goto yy43
-	}
-yy52:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		fallthrough
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
-		fallthrough
-	case 'D':
-		fallthrough
-	case 'E':
-		fallthrough
-	case 'F':
-		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
-		fallthrough
-	case 'd':
-		fallthrough
-	case 'e':
-		fallthrough
-	case 'f':
-		goto yy59
-	case ':':
-		goto yy60
-	case ']':
-		goto yy61
-	default:
-		goto yy43
-	}
-yy53:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case ':':
-		goto yy62
-	default:
-		goto yy43
-	}
-yy54:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case 0x00:
-		goto yy43
-	case '.':
-		goto yy63
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		goto yy64
-	default:
-		goto yy42
-	}
-yy55:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case 0x00:
-		goto yy43
-	case '.':
-		goto yy44
-	default:
-		goto yy42
-	}
-yy56:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		goto yy46
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
-		fallthrough
-	case 'D':
-		fallthrough
-	case 'E':
-		fallthrough
-	case 'F':
-		fallthrough
-	case 'G':
-		fallthrough
-	case 'H':
-		fallthrough
-	case 'I':
-		fallthrough
-	case 'J':
-		fallthrough
-	case 'K':
-		fallthrough
-	case 'L':
-		fallthrough
-	case 'M':
-		fallthrough
-	case 'N':
-		fallthrough
-	case 'O':
-		fallthrough
-	case 'P':
-		fallthrough
-	case 'Q':
-		fallthrough
-	case 'R':
-		fallthrough
-	case 'S':
-		fallthrough
-	case 'T':
-		fallthrough
-	case 'U':
-		fallthrough
-	case 'V':
-		fallthrough
-	case 'W':
-		fallthrough
-	case 'X':
-		fallthrough
-	case 'Y':
-		fallthrough
-	case 'Z':
-		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
-		fallthrough
-	case 'd':
-		fallthrough
-	case 'e':
-		fallthrough
-	case 'f':
-		fallthrough
-	case 'g':
-		fallthrough
-	case 'h':
-		fallthrough
-	case 'i':
-		fallthrough
-	case 'j':
-		fallthrough
-	case 'k':
-		fallthrough
-	case 'l':
-		fallthrough
-	case 'm':
-		fallthrough
-	case 'n':
-		fallthrough
-	case 'o':
-		fallthrough
-	case 'p':
-		fallthrough
-	case 'q':
-		fallthrough
-	case 'r':
-		fallthrough
-	case 's':
-		fallthrough
-	case 't':
-		fallthrough
-	case 'u':
-		fallthrough
-	case 'v':
-		fallthrough
-	case 'w':
-		fallthrough
-	case 'x':
-		fallthrough
-	case 'y':
-		fallthrough
-	case 'z':
-		goto yy37
-	default:
-		goto yy43
-	}
-yy57:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		goto yy57
-	default:
-		goto yy39
-	}
-yy59:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		fallthrough
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
-		fallthrough
-	case 'D':
-		fallthrough
-	case 'E':
-		fallthrough
-	case 'F':
-		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
-		fallthrough
-	case 'd':
-		fallthrough
-	case 'e':
-		fallthrough
-	case 'f':
-		goto yy65
-	case ':':
-		goto yy60
-	case ']':
-		goto yy61
-	default:
-		goto yy43
-	}
-yy60:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		goto yy66
-	case ':':
-		goto yy62
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
-		fallthrough
-	case 'D':
-		fallthrough
-	case 'E':
-		fallthrough
-	case 'F':
-		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
-		fallthrough
-	case 'd':
-		fallthrough
-	case 'e':
-		fallthrough
-	case 'f':
-		goto yy52
-	default:
-		goto yy43
-	}
-yy61:
-	yyaccept = 1
-	cursor += 1
-	marker = cursor
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case ':':
-		goto yy51
-	default:
-		goto yy39
-	}
-yy62:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		fallthrough
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
-		fallthrough
-	case 'D':
-		fallthrough
-	case 'E':
-		fallthrough
-	case 'F':
-		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
-		fallthrough
-	case 'd':
-		fallthrough
-	case 'e':
-		fallthrough
-	case 'f':
-		goto yy67
-	case ':':
-		goto yy68
-	case ']':
-		goto yy61
-	default:
-		goto yy43
-	}
-yy63:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		goto yy69
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
-		fallthrough
-	case 'D':
-		fallthrough
-	case 'E':
-		fallthrough
-	case 'F':
-		fallthrough
-	case 'G':
-		fallthrough
-	case 'H':
-		fallthrough
-	case 'I':
-		fallthrough
-	case 'J':
-		fallthrough
-	case 'K':
-		fallthrough
-	case 'L':
-		fallthrough
-	case 'M':
-		fallthrough
-	case 'N':
-		fallthrough
-	case 'O':
-		fallthrough
-	case 'P':
-		fallthrough
-	case 'Q':
-		fallthrough
-	case 'R':
-		fallthrough
-	case 'S':
-		fallthrough
-	case 'T':
-		fallthrough
-	case 'U':
-		fallthrough
-	case 'V':
-		fallthrough
-	case 'W':
-		fallthrough
-	case 'X':
-		fallthrough
-	case 'Y':
-		fallthrough
-	case 'Z':
-		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
-		fallthrough
-	case 'd':
-		fallthrough
-	case 'e':
-		fallthrough
-	case 'f':
-		fallthrough
-	case 'g':
-		fallthrough
-	case 'h':
-		fallthrough
-	case 'i':
-		fallthrough
-	case 'j':
-		fallthrough
-	case 'k':
-		fallthrough
-	case 'l':
-		fallthrough
-	case 'm':
-		fallthrough
-	case 'n':
-		fallthrough
-	case 'o':
-		fallthrough
-	case 'p':
-		fallthrough
-	case 'q':
-		fallthrough
-	case 'r':
-		fallthrough
-	case 's':
-		fallthrough
-	case 't':
-		fallthrough
-	case 'u':
-		fallthrough
-	case 'v':
-		fallthrough
-	case 'w':
-		fallthrough
-	case 'x':
-		fallthrough
-	case 'y':
-		fallthrough
-	case 'z':
-		goto yy37
-	default:
-		goto yy43
-	}
-yy64:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case 0x00:
-		goto yy43
-	case '.':
-		goto yy63
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		goto yy70
-	default:
-		goto yy42
-	}
-yy65:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		fallthrough
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
-		fallthrough
-	case 'D':
-		fallthrough
-	case 'E':
-		fallthrough
-	case 'F':
-		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
-		fallthrough
-	case 'd':
-		fallthrough
-	case 'e':
-		fallthrough
-	case 'f':
-		goto yy71
-	case ':':
-		goto yy60
-	case ']':
-		goto yy61
-	default:
-		goto yy43
-	}
-yy66:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case '.':
-		goto yy72
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		goto yy73
-	case ':':
-		goto yy60
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
-		fallthrough
-	case 'D':
-		fallthrough
-	case 'E':
-		fallthrough
-	case 'F':
-		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
-		fallthrough
-	case 'd':
-		fallthrough
-	case 'e':
-		fallthrough
-	case 'f':
-		goto yy59
-	case ']':
-		goto yy61
-	default:
-		goto yy43
-	}
-yy67:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		fallthrough
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
-		fallthrough
-	case 'D':
-		fallthrough
-	case 'E':
-		fallthrough
-	case 'F':
-		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
-		fallthrough
-	case 'd':
-		fallthrough
-	case 'e':
-		fallthrough
-	case 'f':
-		goto yy74
-	case ':':
-		goto yy75
-	case ']':
-		goto yy61
-	default:
-		goto yy43
-	}
-yy68:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		goto yy76
-	default:
-		goto yy43
-	}
-yy69:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case 0x00:
-		goto yy43
-	case '.':
-		goto yy77
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		goto yy78
-	default:
-		goto yy42
-	}
-yy70:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case 0x00:
-		goto yy43
-	case '.':
-		goto yy63
-	default:
-		goto yy42
-	}
-yy71:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case ':':
-		goto yy60
-	case ']':
-		goto yy61
-	default:
-		goto yy43
-	}
-yy72:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		goto yy79
-	default:
-		goto yy43
-	}
-yy73:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case '.':
-		goto yy72
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		goto yy80
-	case ':':
-		goto yy60
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
-		fallthrough
-	case 'D':
-		fallthrough
-	case 'E':
-		fallthrough
-	case 'F':
-		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
-		fallthrough
-	case 'd':
-		fallthrough
-	case 'e':
-		fallthrough
-	case 'f':
-		goto yy65
-	case ']':
-		goto yy61
-	default:
-		goto yy43
-	}
-yy74:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		fallthrough
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
-		fallthrough
-	case 'D':
-		fallthrough
-	case 'E':
-		fallthrough
-	case 'F':
-		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
-		fallthrough
-	case 'd':
-		fallthrough
-	case 'e':
-		fallthrough
-	case 'f':
-		goto yy81
-	case ':':
-		goto yy75
-	case ']':
-		goto yy61
-	default:
-		goto yy43
-	}
-yy75:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		goto yy82
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
-		fallthrough
-	case 'D':
-		fallthrough
-	case 'E':
-		fallthrough
-	case 'F':
-		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
-		fallthrough
-	case 'd':
-		fallthrough
-	case 'e':
-		fallthrough
-	case 'f':
-		goto yy67
-	default:
-		goto yy43
-	}
-yy76:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case '.':
-		goto yy72
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		goto yy83
-	default:
-		goto yy43
-	}
-yy77:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		goto yy84
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
-		fallthrough
-	case 'D':
-		fallthrough
-	case 'E':
-		fallthrough
-	case 'F':
-		fallthrough
-	case 'G':
-		fallthrough
-	case 'H':
-		fallthrough
-	case 'I':
-		fallthrough
-	case 'J':
-		fallthrough
-	case 'K':
-		fallthrough
-	case 'L':
-		fallthrough
-	case 'M':
-		fallthrough
-	case 'N':
-		fallthrough
-	case 'O':
-		fallthrough
-	case 'P':
-		fallthrough
-	case 'Q':
-		fallthrough
-	case 'R':
-		fallthrough
-	case 'S':
-		fallthrough
-	case 'T':
-		fallthrough
-	case 'U':
-		fallthrough
-	case 'V':
-		fallthrough
-	case 'W':
-		fallthrough
-	case 'X':
-		fallthrough
-	case 'Y':
-		fallthrough
-	case 'Z':
-		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
-		fallthrough
-	case 'd':
-		fallthrough
-	case 'e':
-		fallthrough
-	case 'f':
-		fallthrough
-	case 'g':
-		fallthrough
-	case 'h':
-		fallthrough
-	case 'i':
-		fallthrough
-	case 'j':
-		fallthrough
-	case 'k':
-		fallthrough
-	case 'l':
-		fallthrough
-	case 'm':
-		fallthrough
-	case 'n':
-		fallthrough
-	case 'o':
-		fallthrough
-	case 'p':
-		fallthrough
-	case 'q':
-		fallthrough
-	case 'r':
-		fallthrough
-	case 's':
-		fallthrough
-	case 't':
-		fallthrough
-	case 'u':
-		fallthrough
-	case 'v':
-		fallthrough
-	case 'w':
-		fallthrough
-	case 'x':
-		fallthrough
-	case 'y':
-		fallthrough
-	case 'z':
-		goto yy37
-	default:
-		goto yy43
-	}
-yy78:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case 0x00:
-		goto yy43
-	case '.':
-		goto yy77
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		goto yy85
-	default:
-		goto yy42
-	}
-yy79:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case '.':
-		goto yy86
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		goto yy87
-	default:
-		goto yy43
-	}
-yy80:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case '.':
-		goto yy72
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		fallthrough
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
-		fallthrough
-	case 'D':
-		fallthrough
-	case 'E':
-		fallthrough
-	case 'F':
-		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
-		fallthrough
-	case 'd':
-		fallthrough
-	case 'e':
-		fallthrough
-	case 'f':
-		goto yy71
-	case ':':
-		goto yy60
-	case ']':
-		goto yy61
-	default:
-		goto yy43
-	}
-yy81:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		fallthrough
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
-		fallthrough
-	case 'D':
-		fallthrough
-	case 'E':
-		fallthrough
-	case 'F':
-		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
-		fallthrough
-	case 'd':
-		fallthrough
-	case 'e':
-		fallthrough
-	case 'f':
-		goto yy88
-	case ':':
-		goto yy75
-	case ']':
-		goto yy61
-	default:
-		goto yy43
-	}
-yy82:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case '.':
-		goto yy72
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		goto yy89
-	case ':':
-		goto yy75
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
-		fallthrough
-	case 'D':
-		fallthrough
-	case 'E':
-		fallthrough
-	case 'F':
-		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
-		fallthrough
-	case 'd':
-		fallthrough
-	case 'e':
-		fallthrough
-	case 'f':
-		goto yy74
-	case ']':
-		goto yy61
-	default:
-		goto yy43
-	}
-yy83:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case '.':
-		goto yy72
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		goto yy90
-	default:
-		goto yy43
-	}
-yy84:
-	yyaccept = 1
-	cursor += 1
-	marker = cursor
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case '-':
-		fallthrough
-	case '.':
-		fallthrough
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
-		fallthrough
-	case 'D':
-		fallthrough
-	case 'E':
-		fallthrough
-	case 'F':
-		fallthrough
-	case 'G':
-		fallthrough
-	case 'H':
-		fallthrough
-	case 'I':
-		fallthrough
-	case 'J':
-		fallthrough
-	case 'K':
-		fallthrough
-	case 'L':
-		fallthrough
-	case 'M':
-		fallthrough
-	case 'N':
-		fallthrough
-	case 'O':
-		fallthrough
-	case 'P':
-		fallthrough
-	case 'Q':
-		fallthrough
-	case 'R':
-		fallthrough
-	case 'S':
-		fallthrough
-	case 'T':
-		fallthrough
-	case 'U':
-		fallthrough
-	case 'V':
-		fallthrough
-	case 'W':
-		fallthrough
-	case 'X':
-		fallthrough
-	case 'Y':
-		fallthrough
-	case 'Z':
-		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
-		fallthrough
-	case 'd':
-		fallthrough
-	case 'e':
-		fallthrough
-	case 'f':
-		fallthrough
-	case 'g':
-		fallthrough
-	case 'h':
-		fallthrough
-	case 'i':
-		fallthrough
-	case 'j':
-		fallthrough
-	case 'k':
-		fallthrough
-	case 'l':
-		fallthrough
-	case 'm':
-		fallthrough
-	case 'n':
-		fallthrough
-	case 'o':
-		fallthrough
-	case 'p':
-		fallthrough
-	case 'q':
-		fallthrough
-	case 'r':
-		fallthrough
-	case 's':
-		fallthrough
-	case 't':
-		fallthrough
-	case 'u':
-		fallthrough
-	case 'v':
-		fallthrough
-	case 'w':
-		fallthrough
-	case 'x':
-		fallthrough
-	case 'y':
-		fallthrough
-	case 'z':
-		goto yy47
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		goto yy91
-	case ':':
-		goto yy51
-	default:
-		goto yy39
-	}
-yy85:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case 0x00:
-		goto yy43
-	case '.':
-		goto yy77
-	default:
-		goto yy42
-	}
-yy86:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		goto yy92
-	default:
-		goto yy43
-	}
-yy87:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case '.':
-		goto yy86
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		goto yy93
-	default:
-		goto yy43
-	}
-yy88:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case ':':
-		goto yy75
-	case ']':
-		goto yy61
-	default:
-		goto yy43
-	}
-yy89:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case '.':
-		goto yy72
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		goto yy94
-	case ':':
-		goto yy75
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
-		fallthrough
-	case 'D':
-		fallthrough
-	case 'E':
-		fallthrough
-	case 'F':
-		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
-		fallthrough
-	case 'd':
-		fallthrough
-	case 'e':
-		fallthrough
-	case 'f':
-		goto yy81
-	case ']':
-		goto yy61
-	default:
-		goto yy43
-	}
-yy90:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case '.':
-		goto yy72
-	default:
-		goto yy43
-	}
-yy91:
-	yyaccept = 1
-	cursor += 1
-	marker = cursor
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case '-':
-		fallthrough
-	case '.':
-		fallthrough
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
-		fallthrough
-	case 'D':
-		fallthrough
-	case 'E':
-		fallthrough
-	case 'F':
-		fallthrough
-	case 'G':
-		fallthrough
-	case 'H':
-		fallthrough
-	case 'I':
-		fallthrough
-	case 'J':
-		fallthrough
-	case 'K':
-		fallthrough
-	case 'L':
-		fallthrough
-	case 'M':
-		fallthrough
-	case 'N':
-		fallthrough
-	case 'O':
-		fallthrough
-	case 'P':
-		fallthrough
-	case 'Q':
-		fallthrough
-	case 'R':
-		fallthrough
-	case 'S':
-		fallthrough
-	case 'T':
-		fallthrough
-	case 'U':
-		fallthrough
-	case 'V':
-		fallthrough
-	case 'W':
-		fallthrough
-	case 'X':
-		fallthrough
-	case 'Y':
-		fallthrough
-	case 'Z':
-		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
-		fallthrough
-	case 'd':
-		fallthrough
-	case 'e':
-		fallthrough
-	case 'f':
-		fallthrough
-	case 'g':
-		fallthrough
-	case 'h':
-		fallthrough
-	case 'i':
-		fallthrough
-	case 'j':
-		fallthrough
-	case 'k':
-		fallthrough
-	case 'l':
-		fallthrough
-	case 'm':
-		fallthrough
-	case 'n':
-		fallthrough
-	case 'o':
-		fallthrough
-	case 'p':
-		fallthrough
-	case 'q':
-		fallthrough
-	case 'r':
-		fallthrough
-	case 's':
-		fallthrough
-	case 't':
-		fallthrough
-	case 'u':
-		fallthrough
-	case 'v':
-		fallthrough
-	case 'w':
-		fallthrough
-	case 'x':
-		fallthrough
-	case 'y':
-		fallthrough
-	case 'z':
-		goto yy47
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		goto yy95
-	case ':':
-		goto yy51
-	default:
-		goto yy39
-	}
-yy92:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case '.':
-		goto yy96
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		goto yy97
-	default:
-		goto yy43
-	}
-yy93:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case '.':
-		goto yy86
-	default:
-		goto yy43
-	}
-yy94:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case '.':
-		goto yy72
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		fallthrough
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
-		fallthrough
-	case 'D':
-		fallthrough
-	case 'E':
-		fallthrough
-	case 'F':
-		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
-		fallthrough
-	case 'd':
-		fallthrough
-	case 'e':
-		fallthrough
-	case 'f':
-		goto yy88
-	case ':':
-		goto yy75
-	case ']':
-		goto yy61
-	default:
-		goto yy43
-	}
-yy95:
-	yyaccept = 1
-	cursor += 1
-	marker = cursor
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case '-':
-		fallthrough
-	case '.':
-		fallthrough
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		fallthrough
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
-		fallthrough
-	case 'D':
-		fallthrough
-	case 'E':
-		fallthrough
-	case 'F':
-		fallthrough
-	case 'G':
-		fallthrough
-	case 'H':
-		fallthrough
-	case 'I':
-		fallthrough
-	case 'J':
-		fallthrough
-	case 'K':
-		fallthrough
-	case 'L':
-		fallthrough
-	case 'M':
-		fallthrough
-	case 'N':
-		fallthrough
-	case 'O':
-		fallthrough
-	case 'P':
-		fallthrough
-	case 'Q':
-		fallthrough
-	case 'R':
-		fallthrough
-	case 'S':
-		fallthrough
-	case 'T':
-		fallthrough
-	case 'U':
-		fallthrough
-	case 'V':
-		fallthrough
-	case 'W':
-		fallthrough
-	case 'X':
-		fallthrough
-	case 'Y':
-		fallthrough
-	case 'Z':
-		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
-		fallthrough
-	case 'd':
-		fallthrough
-	case 'e':
-		fallthrough
-	case 'f':
-		fallthrough
-	case 'g':
-		fallthrough
-	case 'h':
-		fallthrough
-	case 'i':
-		fallthrough
-	case 'j':
-		fallthrough
-	case 'k':
-		fallthrough
-	case 'l':
-		fallthrough
-	case 'm':
-		fallthrough
-	case 'n':
-		fallthrough
-	case 'o':
-		fallthrough
-	case 'p':
-		fallthrough
-	case 'q':
-		fallthrough
-	case 'r':
-		fallthrough
-	case 's':
-		fallthrough
-	case 't':
-		fallthrough
-	case 'u':
-		fallthrough
-	case 'v':
-		fallthrough
-	case 'w':
-		fallthrough
-	case 'x':
-		fallthrough
-	case 'y':
-		fallthrough
-	case 'z':
-		goto yy47
-	case ':':
-		goto yy51
-	default:
-		goto yy39
-	}
-yy96:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		goto yy98
-	default:
-		goto yy43
-	}
-yy97:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case '.':
-		goto yy96
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		goto yy99
-	default:
-		goto yy43
-	}
-yy98:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		goto yy100
-	case ']':
-		goto yy61
-	default:
-		goto yy43
-	}
-yy99:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case '.':
-		goto yy96
-	default:
-		goto yy43
-	}
-yy100:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		goto yy101
-	case ']':
-		goto yy61
-	default:
-		goto yy43
-	}
-yy101:
-	cursor += 1
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case ']':
-		goto yy61
-	default:
-		goto yy43
-	}
-yy102:
-//line "parser.re":84
-	{ err("invalid host or port"); goto fail }
-//line "parser_re.go":4541
-}
-//line "parser.re":89
-
-params:
-	
-//line "parser_re.go":4547
-{
-	var yych byte
-	yyaccept := 0
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case ';':
-		goto yy107
-	case '?':
-		goto yy108
-	default:
-		if (cursor >= limit) {
-			goto yy122
-		}
-		goto yy105
-	}
-yy105:
-	cursor += 1
-yy106:
-//line "parser.re":92
-	{ err("invalid params"); goto fail }
-//line "parser_re.go":4568
-yy107:
-	yyaccept = 0
-	cursor += 1
-	marker = cursor
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case '!':
-		fallthrough
-	case '$':
-		fallthrough
-	case '&':
-		fallthrough
-	case '\'':
-		fallthrough
-	case '(':
-		fallthrough
-	case ')':
-		fallthrough
-	case '*':
-		fallthrough
-	case '+':
-		fallthrough
-	case '-':
-		fallthrough
-	case '.':
-		fallthrough
-	case '/':
-		fallthrough
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		fallthrough
-	case ':':
-		fallthrough
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
-		fallthrough
-	case 'D':
-		fallthrough
-	case 'E':
-		fallthrough
-	case 'F':
-		fallthrough
-	case 'G':
-		fallthrough
-	case 'H':
-		fallthrough
-	case 'I':
-		fallthrough
-	case 'J':
-		fallthrough
-	case 'K':
-		fallthrough
-	case 'L':
-		fallthrough
-	case 'M':
-		fallthrough
-	case 'N':
-		fallthrough
-	case 'O':
-		fallthrough
-	case 'P':
-		fallthrough
-	case 'Q':
-		fallthrough
-	case 'R':
-		fallthrough
-	case 'S':
-		fallthrough
-	case 'T':
-		fallthrough
-	case 'U':
-		fallthrough
-	case 'V':
-		fallthrough
-	case 'W':
-		fallthrough
-	case 'X':
-		fallthrough
-	case 'Y':
-		fallthrough
-	case 'Z':
-		fallthrough
-	case '[':
-		fallthrough
-	case ']':
-		fallthrough
-	case '_':
-		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
-		fallthrough
-	case 'd':
-		fallthrough
-	case 'e':
-		fallthrough
-	case 'f':
-		fallthrough
-	case 'g':
-		fallthrough
-	case 'h':
-		fallthrough
-	case 'i':
-		fallthrough
-	case 'j':
-		fallthrough
-	case 'k':
-		fallthrough
-	case 'l':
-		fallthrough
-	case 'm':
-		fallthrough
-	case 'n':
-		fallthrough
-	case 'o':
-		fallthrough
-	case 'p':
-		fallthrough
-	case 'q':
-		fallthrough
-	case 'r':
-		fallthrough
-	case 's':
-		fallthrough
-	case 't':
-		fallthrough
-	case 'u':
-		fallthrough
-	case 'v':
-		fallthrough
-	case 'w':
-		fallthrough
-	case 'x':
-		fallthrough
-	case 'y':
-		fallthrough
-	case 'z':
-		fallthrough
-	case '~':
-		yyt1 = cursor
-		goto yy110
-	case '%':
-		yyt1 = cursor
-		goto yy113
+		goto yy89
+	case '6','7','8','9':
+		goto yy90
 	default:
-		goto yy106
+		goto yy40
 	}
-yy108:
-	cursor += 1
-//line "parser.re":98
-	{ cursor--; goto headers }
-//line "parser_re.go":4742
-yy110:
-	yyaccept = 1
+yy74:
 	cursor += 1
-	marker = cursor
-	yych = peek(str, cursor, limit)
-	switch (yych) {
-	case '!':
-		fallthrough
-	case '$':
-		fallthrough
-	case '&':
-		fallthrough
-	case '\'':
-		fallthrough
-	case '(':
-		fallthrough
-	case ')':
-		fallthrough
-	case '*':
-		fallthrough
-	case '+':
-		fallthrough
-	case '-':
-		fallthrough
-	case '.':
-		fallthrough
-	case '/':
-		fallthrough
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		fallthrough
-	case ':':
-		fallthrough
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
-		fallthrough
-	case 'D':
-		fallthrough
-	case 'E':
-		fallthrough
-	case 'F':
-		fallthrough
-	case 'G':
-		fallthrough
-	case 'H':
-		fallthrough
-	case 'I':
-		fallthrough
-	case 'J':
-		fallthrough
-	case 'K':
-		fallthrough
-	case 'L':
-		fallthrough
-	case 'M':
-		fallthrough
-	case 'N':
-		fallthrough
-	case 'O':
-		fallthrough
-	case 'P':
-		fallthrough
-	case 'Q':
-		fallthrough
-	case 'R':
-		fallthrough
-	case 'S':
-		fallthrough
-	case 'T':
-		fallthrough
-	case 'U':
-		fallthrough
-	case 'V':
-		fallthrough
-	case 'W':
-		fallthrough
-	case 'X':
-		fallthrough
-	case 'Y':
-		fallthrough
-	case 'Z':
-		fallthrough
-	case '[':
-		fallthrough
-	case ']':
-		fallthrough
-	case '_':
-		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
-		fallthrough
-	case 'd':
-		fallthrough
-	case 'e':
-		fallthrough
-	case 'f':
-		fallthrough
-	case 'g':
-		fallthrough
-	case 'h':
-		fallthrough
-	case 'i':
-		fallthrough
-	case 'j':
-		fallthrough
-	case 'k':
-		fallthrough
-	case 'l':
-		fallthrough
-	case 'm':
-		fallthrough
-	case 'n':
-		fallthrough
-	case 'o':
-		fallthrough
-	case 'p':
-		fallthrough
-	case 'q':
-		fallthrough
-	case 'r':
-		fallthrough
-	case 's':
-		fallthrough
-	case 't':
-		fallthrough
-	case 'u':
-		fallthrough
-	case 'v':
-		fallthrough
-	case 'w':
-		fallthrough
-	case 'x':
-		fallthrough
-	case 'y':
-		fallthrough
-	case 'z':
-		fallthrough
-	case '~':
-		goto yy110
-	case '%':
-		goto yy113
-	case ';':
-		goto yy115
-	case '=':
-		goto yy116
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case 0x00:
+		goto yy41
+	case '.':
+		goto yy88
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy90
 	default:
-		goto yy112
+		goto yy40
 	}
-yy112:
-	ts = yyt1
-	te = cursor
-//line "parser.re":94
-	{
-		uri.params = str[ts:te]
-		goto headers
+yy75:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case ':':
+		goto yy59
+	default:
+		goto yy41
 	}
-//line "parser_re.go":4922
-yy113:
+yy76:
 	cursor += 1
 	yych = peek(str, cursor, limit)
 	switch (yych) {
-	case '0':
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case '1':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case '2':
+	case 'a','b','c','d','e','f':
+		goto yy91
+	case ':':
+		goto yy77
+	default:
+		goto yy41
+	}
+yy77:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case '3':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case '4':
+	case 'a','b','c','d','e','f':
+		goto yy92
+	case ':':
+		goto yy93
+	default:
+		goto yy41
+	}
+yy78:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy82
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy94
+	case ':':
+		goto yy95
+	case 'A','B','C','D','E','F':
 		fallthrough
+	case 'a','b','c','d','e','f':
+		goto yy96
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy79:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy82
+	case '0','1','2','3','4':
+		goto yy94
 	case '5':
+		goto yy97
+	case '6','7','8','9':
+		goto yy98
+	case ':':
+		goto yy95
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		fallthrough
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
-		fallthrough
-	case 'D':
-		fallthrough
-	case 'E':
-		fallthrough
-	case 'F':
-		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
+	case 'a','b','c','d','e','f':
+		goto yy96
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy80:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy82
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy98
+	case ':':
+		goto yy95
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'd':
+	case 'a','b','c','d','e','f':
+		goto yy96
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy81:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'e':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'f':
-		goto yy117
+	case 'a','b','c','d','e','f':
+		goto yy96
+	case ':':
+		goto yy95
+	case ']':
+		goto yy71
 	default:
-		goto yy114
+		goto yy41
 	}
-yy114:
-	cursor = marker
-	if (yyaccept == 0) {
-		goto yy106
-	} else {
-		goto yy112
+yy82:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1':
+		goto yy99
+	case '2':
+		goto yy100
+	case '3','4','5','6','7','8','9':
+		goto yy101
+	default:
+		goto yy41
 	}
-yy115:
+yy83:
 	cursor += 1
 	yych = peek(str, cursor, limit)
 	switch (yych) {
-	case '!':
-		fallthrough
-	case '$':
-		fallthrough
-	case '&':
-		fallthrough
-	case '\'':
-		fallthrough
-	case '(':
-		fallthrough
-	case ')':
-		fallthrough
-	case '*':
-		fallthrough
-	case '+':
-		fallthrough
-	case '-':
-		fallthrough
 	case '.':
+		goto yy82
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy102
+	case ':':
+		goto yy84
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case '/':
-		fallthrough
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
+	case 'a','b','c','d','e','f':
+		goto yy103
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy84:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1':
+		goto yy78
 	case '2':
+		goto yy79
+	case '3','4','5','6','7','8','9':
+		goto yy80
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
+	case 'a','b','c','d','e','f':
+		goto yy81
+	default:
+		goto yy41
+	}
+yy85:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case '9':
+	case 'A','B','C','D','E','F':
 		fallthrough
+	case 'a','b','c','d','e','f':
+		goto yy103
 	case ':':
-		fallthrough
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
-		fallthrough
-	case 'D':
-		fallthrough
-	case 'E':
-		fallthrough
-	case 'F':
-		fallthrough
-	case 'G':
-		fallthrough
-	case 'H':
-		fallthrough
-	case 'I':
-		fallthrough
-	case 'J':
-		fallthrough
-	case 'K':
-		fallthrough
-	case 'L':
-		fallthrough
-	case 'M':
-		fallthrough
-	case 'N':
-		fallthrough
-	case 'O':
-		fallthrough
-	case 'P':
-		fallthrough
-	case 'Q':
-		fallthrough
-	case 'R':
-		fallthrough
-	case 'S':
-		fallthrough
-	case 'T':
-		fallthrough
-	case 'U':
-		fallthrough
-	case 'V':
-		fallthrough
-	case 'W':
-		fallthrough
-	case 'X':
-		fallthrough
-	case 'Y':
-		fallthrough
-	case 'Z':
-		fallthrough
-	case '[':
-		fallthrough
+		goto yy84
 	case ']':
-		fallthrough
-	case '_':
-		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
-		fallthrough
-	case 'd':
-		fallthrough
-	case 'e':
-		fallthrough
-	case 'f':
-		fallthrough
-	case 'g':
-		fallthrough
-	case 'h':
-		fallthrough
-	case 'i':
-		fallthrough
-	case 'j':
-		fallthrough
-	case 'k':
-		fallthrough
-	case 'l':
-		fallthrough
-	case 'm':
-		fallthrough
-	case 'n':
-		fallthrough
-	case 'o':
-		fallthrough
-	case 'p':
-		fallthrough
-	case 'q':
-		fallthrough
-	case 'r':
-		fallthrough
-	case 's':
-		fallthrough
-	case 't':
-		fallthrough
-	case 'u':
-		fallthrough
-	case 'v':
-		fallthrough
-	case 'w':
-		fallthrough
-	case 'x':
-		fallthrough
-	case 'y':
-		fallthrough
-	case 'z':
-		fallthrough
-	case '~':
-		goto yy110
-	case '%':
-		goto yy113
+		goto yy71
 	default:
-		goto yy114
+		goto yy41
 	}
-yy116:
+yy86:
 	cursor += 1
 	yych = peek(str, cursor, limit)
 	switch (yych) {
-	case '!':
-		fallthrough
-	case '$':
-		fallthrough
-	case '&':
-		fallthrough
-	case '\'':
-		fallthrough
-	case '(':
-		fallthrough
-	case ')':
-		fallthrough
-	case '*':
-		fallthrough
-	case '+':
-		fallthrough
-	case '-':
-		fallthrough
 	case '.':
+		goto yy82
+	case '0','1','2','3','4','5':
+		goto yy102
+	case '6','7','8','9':
 		fallthrough
-	case '/':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case '0':
+	case 'a','b','c','d','e','f':
+		goto yy103
+	case ':':
+		goto yy84
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy87:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy82
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case '1':
+	case 'A','B','C','D','E','F':
 		fallthrough
+	case 'a','b','c','d','e','f':
+		goto yy103
+	case ':':
+		goto yy84
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy88:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1':
+		goto yy104
 	case '2':
+		goto yy105
+	case '3','4','5','6','7','8','9':
+		goto yy106
+	case 'A','B','C','D','E','F','G','H','I','J','K','L','M','N','O','P','Q','R','S','T','U','V','W','X','Y','Z':
 		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
+	case 'a','b','c','d','e','f','g','h','i','j','k','l','m','n','o','p','q','r','s','t','u','v','w','x','y','z':
+		goto yy36
+	default:
+		goto yy41
+	}
+yy89:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case 0x00:
+		goto yy41
+	case '.':
+		goto yy88
+	case '0','1','2','3','4','5':
+		goto yy90
+	default:
+		goto yy40
+	}
+yy90:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case 0x00:
+		goto yy41
+	case '.':
+		goto yy88
+	default:
+		goto yy40
+	}
+yy91:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case '9':
+	case 'A','B','C','D','E','F':
 		fallthrough
+	case 'a','b','c','d','e','f':
+		goto yy107
 	case ':':
+		goto yy77
+	default:
+		goto yy41
+	}
+yy92:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'D':
+	case 'a','b','c','d','e','f':
+		goto yy108
+	case ':':
+		goto yy109
+	default:
+		goto yy41
+	}
+yy93:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1':
+		goto yy110
+	case '2':
+		goto yy111
+	case '3','4','5','6','7','8','9':
+		goto yy112
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'E':
+	case 'a','b','c','d','e','f':
+		goto yy113
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy94:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy82
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy114
+	case ':':
+		goto yy95
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'F':
+	case 'a','b','c','d','e','f':
+		goto yy115
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy95:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1':
+		goto yy110
+	case '2':
+		goto yy111
+	case '3','4','5','6','7','8','9':
+		goto yy112
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'G':
+	case 'a','b','c','d','e','f':
+		goto yy113
+	default:
+		goto yy41
+	}
+yy96:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'H':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'I':
+	case 'a','b','c','d','e','f':
+		goto yy115
+	case ':':
+		goto yy95
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy97:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy82
+	case '0','1','2','3','4','5':
+		goto yy114
+	case '6','7','8','9':
 		fallthrough
-	case 'J':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'K':
+	case 'a','b','c','d','e','f':
+		goto yy115
+	case ':':
+		goto yy95
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy98:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy82
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'L':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'M':
+	case 'a','b','c','d','e','f':
+		goto yy115
+	case ':':
+		goto yy95
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy99:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy116
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy101
+	default:
+		goto yy41
+	}
+yy100:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy116
+	case '0','1','2','3','4':
+		goto yy101
+	case '5':
+		goto yy117
+	case '6','7','8','9':
+		goto yy118
+	default:
+		goto yy41
+	}
+yy101:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy116
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy118
+	default:
+		goto yy41
+	}
+yy102:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy82
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'N':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'O':
+	case 'a','b','c','d','e','f':
+		goto yy119
+	case ':':
+		goto yy84
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy103:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'P':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'Q':
+	case 'a','b','c','d','e','f':
+		goto yy119
+	case ':':
+		goto yy84
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy104:
+	yyaccept = 1
+	cursor += 1
+	marker = cursor
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '-','.':
 		fallthrough
-	case 'R':
+	case 'A','B','C','D','E','F','G','H','I','J','K','L','M','N','O','P','Q','R','S','T','U','V','W','X','Y','Z':
 		fallthrough
-	case 'S':
+	case 'a','b','c','d','e','f','g','h','i','j','k','l','m','n','o','p','q','r','s','t','u','v','w','x','y','z':
+		goto yy45
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy106
+	case ':':
+		goto yy50
+	default:
+		yyt2 = -1
+		goto yy37
+	}
+yy105:
+	yyaccept = 1
+	cursor += 1
+	marker = cursor
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '-','.':
 		fallthrough
-	case 'T':
+	case 'A','B','C','D','E','F','G','H','I','J','K','L','M','N','O','P','Q','R','S','T','U','V','W','X','Y','Z':
 		fallthrough
-	case 'U':
+	case 'a','b','c','d','e','f','g','h','i','j','k','l','m','n','o','p','q','r','s','t','u','v','w','x','y','z':
+		goto yy45
+	case '0','1','2','3','4':
+		goto yy106
+	case '5':
+		goto yy120
+	case '6','7','8','9':
+		goto yy121
+	case ':':
+		goto yy50
+	default:
+		yyt2 = -1
+		goto yy37
+	}
+yy106:
+	yyaccept = 1
+	cursor += 1
+	marker = cursor
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '-','.':
 		fallthrough
-	case 'V':
+	case 'A','B','C','D','E','F','G','H','I','J','K','L','M','N','O','P','Q','R','S','T','U','V','W','X','Y','Z':
 		fallthrough
-	case 'W':
+	case 'a','b','c','d','e','f','g','h','i','j','k','l','m','n','o','p','q','r','s','t','u','v','w','x','y','z':
+		goto yy45
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy121
+	case ':':
+		goto yy50
+	default:
+		yyt2 = -1
+		goto yy37
+	}
+yy107:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case ':':
+		goto yy77
+	default:
+		goto yy41
+	}
+yy108:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'X':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'Y':
+	case 'a','b','c','d','e','f':
+		goto yy122
+	case ':':
+		goto yy109
+	default:
+		goto yy41
+	}
+yy109:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'Z':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case '[':
+	case 'a','b','c','d','e','f':
+		goto yy123
+	case ':':
+		goto yy124
+	default:
+		goto yy41
+	}
+yy110:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy82
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy125
+	case ':':
+		goto yy126
+	case 'A','B','C','D','E','F':
 		fallthrough
+	case 'a','b','c','d','e','f':
+		goto yy127
 	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy111:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy82
+	case '0','1','2','3','4':
+		goto yy125
+	case '5':
+		goto yy128
+	case '6','7','8','9':
+		goto yy129
+	case ':':
+		goto yy126
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case '_':
-		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
-		fallthrough
-	case 'd':
-		fallthrough
-	case 'e':
-		fallthrough
-	case 'f':
-		fallthrough
-	case 'g':
-		fallthrough
-	case 'h':
+	case 'a','b','c','d','e','f':
+		goto yy127
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy112:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy82
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy129
+	case ':':
+		goto yy126
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'i':
+	case 'a','b','c','d','e','f':
+		goto yy127
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy113:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'j':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'k':
+	case 'a','b','c','d','e','f':
+		goto yy127
+	case ':':
+		goto yy126
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy114:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy82
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'l':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'm':
+	case 'a','b','c','d','e','f':
+		goto yy130
+	case ':':
+		goto yy95
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy115:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'n':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'o':
+	case 'a','b','c','d','e','f':
+		goto yy130
+	case ':':
+		goto yy95
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy116:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1':
+		goto yy131
+	case '2':
+		goto yy132
+	case '3','4','5','6','7','8','9':
+		goto yy133
+	default:
+		goto yy41
+	}
+yy117:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy116
+	case '0','1','2','3','4','5':
+		goto yy118
+	default:
+		goto yy41
+	}
+yy118:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy116
+	default:
+		goto yy41
+	}
+yy119:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case ':':
+		goto yy84
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy120:
+	yyaccept = 1
+	cursor += 1
+	marker = cursor
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '-','.':
 		fallthrough
-	case 'p':
+	case '6','7','8','9':
 		fallthrough
-	case 'q':
+	case 'A','B','C','D','E','F','G','H','I','J','K','L','M','N','O','P','Q','R','S','T','U','V','W','X','Y','Z':
 		fallthrough
-	case 'r':
+	case 'a','b','c','d','e','f','g','h','i','j','k','l','m','n','o','p','q','r','s','t','u','v','w','x','y','z':
+		goto yy45
+	case '0','1','2','3','4','5':
+		goto yy121
+	case ':':
+		goto yy50
+	default:
+		yyt2 = -1
+		goto yy37
+	}
+yy121:
+	yyaccept = 1
+	cursor += 1
+	marker = cursor
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '-','.':
 		fallthrough
-	case 's':
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 't':
+	case 'A','B','C','D','E','F','G','H','I','J','K','L','M','N','O','P','Q','R','S','T','U','V','W','X','Y','Z':
 		fallthrough
-	case 'u':
+	case 'a','b','c','d','e','f','g','h','i','j','k','l','m','n','o','p','q','r','s','t','u','v','w','x','y','z':
+		goto yy45
+	case ':':
+		goto yy50
+	default:
+		yyt2 = -1
+		goto yy37
+	}
+yy122:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'v':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'w':
+	case 'a','b','c','d','e','f':
+		goto yy134
+	case ':':
+		goto yy109
+	default:
+		goto yy41
+	}
+yy123:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'x':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'y':
+	case 'a','b','c','d','e','f':
+		goto yy135
+	case ':':
+		goto yy136
+	default:
+		goto yy41
+	}
+yy124:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1':
+		goto yy137
+	case '2':
+		goto yy138
+	case '3','4','5','6','7','8','9':
+		goto yy139
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'z':
+	case 'a','b','c','d','e','f':
+		goto yy140
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy125:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy82
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy141
+	case ':':
+		goto yy126
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case '~':
-		goto yy118
-	case '%':
-		goto yy120
+	case 'a','b','c','d','e','f':
+		goto yy142
+	case ']':
+		goto yy71
 	default:
-		goto yy114
+		goto yy41
 	}
-yy117:
+yy126:
 	cursor += 1
 	yych = peek(str, cursor, limit)
 	switch (yych) {
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
+	case '0','1':
+		goto yy137
 	case '2':
+		goto yy138
+	case '3','4','5','6','7','8','9':
+		goto yy139
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		fallthrough
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
-		fallthrough
-	case 'D':
-		fallthrough
-	case 'E':
-		fallthrough
-	case 'F':
-		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
-		fallthrough
-	case 'd':
-		fallthrough
-	case 'e':
-		fallthrough
-	case 'f':
-		goto yy110
+	case 'a','b','c','d','e','f':
+		goto yy140
 	default:
-		goto yy114
+		goto yy41
 	}
-yy118:
-	yyaccept = 1
+yy127:
 	cursor += 1
-	marker = cursor
 	yych = peek(str, cursor, limit)
 	switch (yych) {
-	case '!':
-		fallthrough
-	case '$':
-		fallthrough
-	case '&':
-		fallthrough
-	case '\'':
-		fallthrough
-	case '(':
-		fallthrough
-	case ')':
-		fallthrough
-	case '*':
-		fallthrough
-	case '+':
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case '-':
+	case 'A','B','C','D','E','F':
 		fallthrough
+	case 'a','b','c','d','e','f':
+		goto yy142
+	case ':':
+		goto yy126
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy128:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
 	case '.':
+		goto yy82
+	case '0','1','2','3','4','5':
+		goto yy141
+	case '6','7','8','9':
 		fallthrough
-	case '/':
-		fallthrough
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case '3':
+	case 'a','b','c','d','e','f':
+		goto yy142
+	case ':':
+		goto yy126
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy129:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy82
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case '4':
+	case 'A','B','C','D','E','F':
 		fallthrough
+	case 'a','b','c','d','e','f':
+		goto yy142
+	case ':':
+		goto yy126
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy130:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case ':':
+		goto yy95
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy131:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy143
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy133
+	default:
+		goto yy41
+	}
+yy132:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy143
+	case '0','1','2','3','4':
+		goto yy133
 	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		fallthrough
+		goto yy144
+	case '6','7','8','9':
+		goto yy145
+	default:
+		goto yy41
+	}
+yy133:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy143
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy145
+	default:
+		goto yy41
+	}
+yy134:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
 	case ':':
+		goto yy109
+	default:
+		goto yy41
+	}
+yy135:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
-		fallthrough
-	case 'D':
-		fallthrough
-	case 'E':
-		fallthrough
-	case 'F':
-		fallthrough
-	case 'G':
-		fallthrough
-	case 'H':
-		fallthrough
-	case 'I':
-		fallthrough
-	case 'J':
-		fallthrough
-	case 'K':
-		fallthrough
-	case 'L':
-		fallthrough
-	case 'M':
-		fallthrough
-	case 'N':
-		fallthrough
-	case 'O':
-		fallthrough
-	case 'P':
-		fallthrough
-	case 'Q':
-		fallthrough
-	case 'R':
-		fallthrough
-	case 'S':
-		fallthrough
-	case 'T':
-		fallthrough
-	case 'U':
-		fallthrough
-	case 'V':
-		fallthrough
-	case 'W':
-		fallthrough
-	case 'X':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'Y':
+	case 'a','b','c','d','e','f':
+		goto yy146
+	case ':':
+		goto yy136
+	default:
+		goto yy41
+	}
+yy136:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'Z':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case '[':
+	case 'a','b','c','d','e','f':
+		goto yy147
+	case ':':
+		goto yy148
+	default:
+		goto yy41
+	}
+yy137:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy82
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy149
+	case ':':
+		goto yy150
+	case 'A','B','C','D','E','F':
 		fallthrough
+	case 'a','b','c','d','e','f':
+		goto yy151
 	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy138:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy82
+	case '0','1','2','3','4':
+		goto yy149
+	case '5':
+		goto yy152
+	case '6','7','8','9':
+		goto yy153
+	case ':':
+		goto yy150
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case '_':
-		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
-		fallthrough
-	case 'd':
-		fallthrough
-	case 'e':
-		fallthrough
-	case 'f':
-		fallthrough
-	case 'g':
-		fallthrough
-	case 'h':
-		fallthrough
-	case 'i':
-		fallthrough
-	case 'j':
-		fallthrough
-	case 'k':
-		fallthrough
-	case 'l':
-		fallthrough
-	case 'm':
-		fallthrough
-	case 'n':
-		fallthrough
-	case 'o':
-		fallthrough
-	case 'p':
-		fallthrough
-	case 'q':
-		fallthrough
-	case 'r':
-		fallthrough
-	case 's':
-		fallthrough
-	case 't':
-		fallthrough
-	case 'u':
-		fallthrough
-	case 'v':
-		fallthrough
-	case 'w':
-		fallthrough
-	case 'x':
+	case 'a','b','c','d','e','f':
+		goto yy151
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy139:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy82
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy153
+	case ':':
+		goto yy150
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'y':
+	case 'a','b','c','d','e','f':
+		goto yy151
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy140:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'z':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case '~':
-		goto yy118
-	case '%':
-		goto yy120
-	case ';':
-		goto yy115
+	case 'a','b','c','d','e','f':
+		goto yy151
+	case ':':
+		goto yy150
+	case ']':
+		goto yy71
 	default:
-		goto yy112
+		goto yy41
 	}
-yy120:
+yy141:
 	cursor += 1
 	yych = peek(str, cursor, limit)
 	switch (yych) {
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		fallthrough
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
-		fallthrough
-	case 'D':
-		fallthrough
-	case 'E':
-		fallthrough
-	case 'F':
-		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
-		fallthrough
-	case 'd':
+	case '.':
+		goto yy82
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'e':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'f':
-		goto yy121
+	case 'a','b','c','d','e','f':
+		goto yy154
+	case ':':
+		goto yy126
+	case ']':
+		goto yy71
 	default:
-		goto yy114
+		goto yy41
 	}
-yy121:
+yy142:
 	cursor += 1
 	yych = peek(str, cursor, limit)
 	switch (yych) {
-	case '0':
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case '1':
+	case 'A','B','C','D','E','F':
 		fallthrough
+	case 'a','b','c','d','e','f':
+		goto yy154
+	case ':':
+		goto yy126
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy143:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1':
+		goto yy155
 	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		fallthrough
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
-		fallthrough
-	case 'D':
-		fallthrough
-	case 'E':
-		fallthrough
-	case 'F':
-		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
-		fallthrough
-	case 'd':
-		fallthrough
-	case 'e':
-		fallthrough
-	case 'f':
-		goto yy118
+		goto yy156
+	case '3','4','5','6','7','8','9':
+		goto yy157
 	default:
-		goto yy114
+		goto yy41
 	}
-yy122:
-//line "parser.re":93
-	{ goto done }
-//line "parser_re.go":5636
-}
-//line "parser.re":99
-
-headers:
-	
-//line "parser_re.go":5642
-{
-	var yych byte
-	yyaccept := 0
+yy144:
+	cursor += 1
 	yych = peek(str, cursor, limit)
 	switch (yych) {
-	case '?':
-		goto yy127
+	case '.':
+		goto yy143
+	case '0','1','2','3','4','5':
+		goto yy145
 	default:
-		if (cursor >= limit) {
-			goto yy139
-		}
-		goto yy125
+		goto yy41
 	}
-yy125:
+yy145:
 	cursor += 1
-yy126:
-//line "parser.re":102
-	{ err("invalid headers"); goto fail }
-//line "parser_re.go":5661
-yy127:
-	yyaccept = 0
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy143
+	default:
+		goto yy41
+	}
+yy146:
 	cursor += 1
-	marker = cursor
 	yych = peek(str, cursor, limit)
 	switch (yych) {
-	case '!':
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case '$':
-		fallthrough
-	case '\'':
-		fallthrough
-	case '(':
-		fallthrough
-	case ')':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case '*':
+	case 'a','b','c','d','e','f':
+		goto yy158
+	case ':':
+		goto yy136
+	default:
+		goto yy41
+	}
+yy147:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case '+':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case '-':
+	case 'a','b','c','d','e','f':
+		goto yy159
+	case ':':
+		goto yy160
+	default:
+		goto yy41
+	}
+yy148:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1':
+		goto yy161
+	case '2':
+		goto yy162
+	case '3','4','5','6','7','8','9':
+		goto yy163
+	case 'A','B','C','D','E','F':
 		fallthrough
+	case 'a','b','c','d','e','f':
+		goto yy164
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy149:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
 	case '.':
+		goto yy82
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy165
+	case ':':
+		goto yy150
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case '/':
-		fallthrough
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
+	case 'a','b','c','d','e','f':
+		goto yy166
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy150:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1':
+		goto yy161
 	case '2':
+		goto yy162
+	case '3','4','5','6','7','8','9':
+		goto yy163
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
+	case 'a','b','c','d','e','f':
+		goto yy164
+	default:
+		goto yy41
+	}
+yy151:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case '9':
+	case 'A','B','C','D','E','F':
 		fallthrough
+	case 'a','b','c','d','e','f':
+		goto yy166
 	case ':':
-		fallthrough
-	case '?':
-		fallthrough
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
-		fallthrough
-	case 'D':
-		fallthrough
-	case 'E':
-		fallthrough
-	case 'F':
-		fallthrough
-	case 'G':
-		fallthrough
-	case 'H':
-		fallthrough
-	case 'I':
-		fallthrough
-	case 'J':
-		fallthrough
-	case 'K':
-		fallthrough
-	case 'L':
-		fallthrough
-	case 'M':
-		fallthrough
-	case 'N':
-		fallthrough
-	case 'O':
-		fallthrough
-	case 'P':
-		fallthrough
-	case 'Q':
-		fallthrough
-	case 'R':
-		fallthrough
-	case 'S':
-		fallthrough
-	case 'T':
-		fallthrough
-	case 'U':
-		fallthrough
-	case 'V':
-		fallthrough
-	case 'W':
-		fallthrough
-	case 'X':
-		fallthrough
-	case 'Y':
-		fallthrough
-	case 'Z':
-		fallthrough
-	case '[':
-		fallthrough
+		goto yy150
 	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy152:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy82
+	case '0','1','2','3','4','5':
+		goto yy165
+	case '6','7','8','9':
 		fallthrough
-	case '_':
-		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
-		fallthrough
-	case 'd':
-		fallthrough
-	case 'e':
-		fallthrough
-	case 'f':
-		fallthrough
-	case 'g':
-		fallthrough
-	case 'h':
-		fallthrough
-	case 'i':
-		fallthrough
-	case 'j':
-		fallthrough
-	case 'k':
-		fallthrough
-	case 'l':
-		fallthrough
-	case 'm':
-		fallthrough
-	case 'n':
-		fallthrough
-	case 'o':
-		fallthrough
-	case 'p':
-		fallthrough
-	case 'q':
-		fallthrough
-	case 'r':
-		fallthrough
-	case 's':
-		fallthrough
-	case 't':
-		fallthrough
-	case 'u':
-		fallthrough
-	case 'v':
-		fallthrough
-	case 'w':
-		fallthrough
-	case 'x':
-		fallthrough
-	case 'y':
-		fallthrough
-	case 'z':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case '~':
-		yyt1 = cursor
-		goto yy128
-	case '%':
-		yyt1 = cursor
-		goto yy131
+	case 'a','b','c','d','e','f':
+		goto yy166
+	case ':':
+		goto yy150
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy153:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy82
+	case '0','1','2','3','4','5','6','7','8','9':
+		fallthrough
+	case 'A','B','C','D','E','F':
+		fallthrough
+	case 'a','b','c','d','e','f':
+		goto yy166
+	case ':':
+		goto yy150
+	case ']':
+		goto yy71
 	default:
+		goto yy41
+	}
+yy154:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case ':':
 		goto yy126
+	case ']':
+		goto yy71
+	default:
+		goto yy41
 	}
-yy128:
+yy155:
 	cursor += 1
 	yych = peek(str, cursor, limit)
 	switch (yych) {
-	case '!':
-		fallthrough
-	case '$':
-		fallthrough
-	case '\'':
-		fallthrough
-	case '(':
-		fallthrough
-	case ')':
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy157
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy156:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4':
+		goto yy157
+	case '5':
+		goto yy167
+	case '6','7','8','9':
+		goto yy168
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy157:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy168
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy158:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case ':':
+		goto yy136
+	default:
+		goto yy41
+	}
+yy159:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case '*':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case '+':
+	case 'a','b','c','d','e','f':
+		goto yy169
+	case ':':
+		goto yy160
+	default:
+		goto yy41
+	}
+yy160:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case '-':
+	case 'A','B','C','D','E','F':
 		fallthrough
+	case 'a','b','c','d','e','f':
+		goto yy170
+	case ':':
+		goto yy171
+	default:
+		goto yy41
+	}
+yy161:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
 	case '.':
+		goto yy82
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy172
+	case ':':
+		goto yy173
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case '/':
-		fallthrough
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
+	case 'a','b','c','d','e','f':
+		goto yy174
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy162:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy82
+	case '0','1','2','3','4':
+		goto yy172
 	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		fallthrough
+		goto yy175
+	case '6','7','8','9':
+		goto yy176
 	case ':':
+		goto yy173
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case '?':
-		fallthrough
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
-		fallthrough
-	case 'D':
+	case 'a','b','c','d','e','f':
+		goto yy174
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy163:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy82
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy176
+	case ':':
+		goto yy173
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'E':
+	case 'a','b','c','d','e','f':
+		goto yy174
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy164:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'F':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'G':
+	case 'a','b','c','d','e','f':
+		goto yy174
+	case ':':
+		goto yy173
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy165:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy82
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'H':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'I':
+	case 'a','b','c','d','e','f':
+		goto yy177
+	case ':':
+		goto yy150
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy166:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'J':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'K':
+	case 'a','b','c','d','e','f':
+		goto yy177
+	case ':':
+		goto yy150
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy167:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5':
+		goto yy168
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy168:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy169:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'L':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'M':
+	case 'a','b','c','d','e','f':
+		goto yy178
+	case ':':
+		goto yy160
+	default:
+		goto yy41
+	}
+yy170:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'N':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'O':
+	case 'a','b','c','d','e','f':
+		goto yy179
+	case ':':
+		goto yy180
+	default:
+		goto yy41
+	}
+yy171:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1':
+		goto yy181
+	case '2':
+		goto yy182
+	case '3','4','5','6','7','8','9':
+		goto yy183
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'P':
+	case 'a','b','c','d','e','f':
+		goto yy184
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy172:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy82
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy185
+	case ':':
+		goto yy173
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'Q':
+	case 'a','b','c','d','e','f':
+		goto yy186
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy173:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1':
+		goto yy181
+	case '2':
+		goto yy182
+	case '3','4','5','6','7','8','9':
+		goto yy183
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'R':
+	case 'a','b','c','d','e','f':
+		goto yy184
+	default:
+		goto yy41
+	}
+yy174:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'S':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'T':
+	case 'a','b','c','d','e','f':
+		goto yy186
+	case ':':
+		goto yy173
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy175:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy82
+	case '0','1','2','3','4','5':
+		goto yy185
+	case '6','7','8','9':
 		fallthrough
-	case 'U':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'V':
+	case 'a','b','c','d','e','f':
+		goto yy186
+	case ':':
+		goto yy173
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy176:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy82
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'W':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'X':
+	case 'a','b','c','d','e','f':
+		goto yy186
+	case ':':
+		goto yy173
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy177:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case ':':
+		goto yy150
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy178:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case ':':
+		goto yy160
+	default:
+		goto yy41
+	}
+yy179:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'Y':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'Z':
+	case 'a','b','c','d','e','f':
+		goto yy187
+	case ':':
+		goto yy180
+	default:
+		goto yy41
+	}
+yy180:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1':
+		goto yy188
+	case '2':
+		goto yy189
+	case '3','4','5','6','7','8','9':
+		goto yy190
+	case ':':
+		goto yy191
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case '[':
+	case 'a','b','c','d','e','f':
+		goto yy192
+	default:
+		goto yy41
+	}
+yy181:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy82
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy193
+	case ':':
+		goto yy194
+	case 'A','B','C','D','E','F':
 		fallthrough
+	case 'a','b','c','d','e','f':
+		goto yy195
 	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy182:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy82
+	case '0','1','2','3','4':
+		goto yy193
+	case '5':
+		goto yy196
+	case '6','7','8','9':
+		goto yy197
+	case ':':
+		goto yy194
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case '_':
-		fallthrough
-	case 'a':
-		fallthrough
-	case 'b':
+	case 'a','b','c','d','e','f':
+		goto yy195
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy183:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy82
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy197
+	case ':':
+		goto yy194
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'c':
+	case 'a','b','c','d','e','f':
+		goto yy195
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy184:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'd':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'e':
+	case 'a','b','c','d','e','f':
+		goto yy195
+	case ':':
+		goto yy194
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy185:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy82
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'f':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'g':
+	case 'a','b','c','d','e','f':
+		goto yy198
+	case ':':
+		goto yy173
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy186:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'h':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'i':
+	case 'a','b','c','d','e','f':
+		goto yy198
+	case ':':
+		goto yy173
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy187:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'j':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'k':
+	case 'a','b','c','d','e','f':
+		goto yy199
+	case ':':
+		goto yy180
+	default:
+		goto yy41
+	}
+yy188:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy82
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy200
+	case ':':
+		goto yy201
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'l':
+	case 'a','b','c','d','e','f':
+		goto yy202
+	default:
+		goto yy41
+	}
+yy189:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy82
+	case '0','1','2','3','4':
+		goto yy200
+	case '5':
+		goto yy203
+	case '6','7','8','9':
+		goto yy204
+	case ':':
+		goto yy201
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'm':
+	case 'a','b','c','d','e','f':
+		goto yy202
+	default:
+		goto yy41
+	}
+yy190:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy82
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy204
+	case ':':
+		goto yy201
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'n':
+	case 'a','b','c','d','e','f':
+		goto yy202
+	default:
+		goto yy41
+	}
+yy191:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'o':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'p':
+	case 'a','b','c','d','e','f':
+		goto yy205
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy192:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'q':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'r':
+	case 'a','b','c','d','e','f':
+		goto yy202
+	case ':':
+		goto yy201
+	default:
+		goto yy41
+	}
+yy193:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy82
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy206
+	case ':':
+		goto yy194
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 's':
+	case 'a','b','c','d','e','f':
+		goto yy207
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy194:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 't':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'u':
+	case 'a','b','c','d','e','f':
+		goto yy205
+	default:
+		goto yy41
+	}
+yy195:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'v':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'w':
+	case 'a','b','c','d','e','f':
+		goto yy207
+	case ':':
+		goto yy194
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy196:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy82
+	case '0','1','2','3','4','5':
+		goto yy206
+	case '6','7','8','9':
 		fallthrough
-	case 'x':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'y':
+	case 'a','b','c','d','e','f':
+		goto yy207
+	case ':':
+		goto yy194
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy197:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy82
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'z':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case '~':
-		goto yy128
-	case '%':
-		goto yy131
-	case '=':
-		goto yy132
+	case 'a','b','c','d','e','f':
+		goto yy207
+	case ':':
+		goto yy194
+	case ']':
+		goto yy71
 	default:
-		goto yy130
+		goto yy41
 	}
-yy130:
-	cursor = marker
-	if (yyaccept == 0) {
-		goto yy126
-	} else {
-		goto yy134
+yy198:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case ':':
+		goto yy173
+	case ']':
+		goto yy71
+	default:
+		goto yy41
 	}
-yy131:
+yy199:
 	cursor += 1
 	yych = peek(str, cursor, limit)
 	switch (yych) {
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
-		fallthrough
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
-		fallthrough
-	case 'D':
-		fallthrough
-	case 'E':
+	case ':':
+		goto yy180
+	default:
+		goto yy41
+	}
+yy200:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy82
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy208
+	case ':':
+		goto yy201
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'F':
+	case 'a','b','c','d','e','f':
+		goto yy209
+	default:
+		goto yy41
+	}
+yy201:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'a':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'b':
+	case 'a','b','c','d','e','f':
+		goto yy205
+	case ':':
+		goto yy168
+	default:
+		goto yy41
+	}
+yy202:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'c':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'd':
+	case 'a','b','c','d','e','f':
+		goto yy209
+	case ':':
+		goto yy201
+	default:
+		goto yy41
+	}
+yy203:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy82
+	case '0','1','2','3','4','5':
+		goto yy208
+	case '6','7','8','9':
 		fallthrough
-	case 'e':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'f':
-		goto yy135
+	case 'a','b','c','d','e','f':
+		goto yy209
+	case ':':
+		goto yy201
 	default:
-		goto yy130
+		goto yy41
 	}
-yy132:
-	yyaccept = 1
+yy204:
 	cursor += 1
-	marker = cursor
 	yych = peek(str, cursor, limit)
 	switch (yych) {
-	case '!':
-		fallthrough
-	case '$':
-		fallthrough
-	case '\'':
-		fallthrough
-	case '(':
-		fallthrough
-	case ')':
-		fallthrough
-	case '*':
-		fallthrough
-	case '+':
-		fallthrough
-	case '-':
-		fallthrough
 	case '.':
+		goto yy82
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case '/':
-		fallthrough
-	case '0':
-		fallthrough
-	case '1':
-		fallthrough
-	case '2':
-		fallthrough
-	case '3':
-		fallthrough
-	case '4':
-		fallthrough
-	case '5':
-		fallthrough
-	case '6':
-		fallthrough
-	case '7':
-		fallthrough
-	case '8':
-		fallthrough
-	case '9':
+	case 'A','B','C','D','E','F':
 		fallthrough
+	case 'a','b','c','d','e','f':
+		goto yy209
 	case ':':
+		goto yy201
+	default:
+		goto yy41
+	}
+yy205:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case '?':
-		fallthrough
-	case 'A':
-		fallthrough
-	case 'B':
-		fallthrough
-	case 'C':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'D':
+	case 'a','b','c','d','e','f':
+		goto yy210
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy206:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy82
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'E':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'F':
+	case 'a','b','c','d','e','f':
+		goto yy211
+	case ':':
+		goto yy194
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy207:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'G':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'H':
+	case 'a','b','c','d','e','f':
+		goto yy211
+	case ':':
+		goto yy194
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy208:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '.':
+		goto yy82
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'I':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'J':
+	case 'a','b','c','d','e','f':
+		goto yy212
+	case ':':
+		goto yy201
+	default:
+		goto yy41
+	}
+yy209:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'K':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'L':
+	case 'a','b','c','d','e','f':
+		goto yy212
+	case ':':
+		goto yy201
+	default:
+		goto yy41
+	}
+yy210:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'M':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'N':
+	case 'a','b','c','d','e','f':
+		goto yy213
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy211:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case ':':
+		goto yy194
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy212:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case ':':
+		goto yy201
+	default:
+		goto yy41
+	}
+yy213:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'O':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'P':
+	case 'a','b','c','d','e','f':
+		goto yy168
+	case ']':
+		goto yy71
+	default:
+		goto yy41
+	}
+yy214:
+//line "parser.re":118
+	{ err(); goto fail }
+//line "parser_re.go":3292
+}
+//line "parser.re":131
+
+params:
+	
+//line "parser_re.go":3298
+{
+	var yych byte
+	yyaccept := 0
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case ';':
+		goto yy218
+	case '?':
+		goto yy219
+	default:
+		if (cursor >= limit) {
+			goto yy231
+		}
+		goto yy216
+	}
+yy216:
+	cursor += 1
+yy217:
+//line "parser.re":134
+	{ cursor--; err(); goto fail }
+//line "parser_re.go":3319
+yy218:
+	yyaccept = 0
+	cursor += 1
+	marker = cursor
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '!':
 		fallthrough
-	case 'Q':
+	case '$','%','&','\'','(',')','*','+':
 		fallthrough
-	case 'R':
+	case '-','.','/','0','1','2','3','4','5','6','7','8','9',':':
 		fallthrough
-	case 'S':
+	case 'A','B','C','D','E','F','G','H','I','J','K','L','M','N','O','P','Q','R','S','T','U','V','W','X','Y','Z','[':
 		fallthrough
-	case 'T':
+	case ']':
 		fallthrough
-	case 'U':
+	case '_':
 		fallthrough
-	case 'V':
+	case 'a','b','c','d','e','f','g','h','i','j','k','l','m','n','o','p','q','r','s','t','u','v','w','x','y','z':
 		fallthrough
-	case 'W':
+	case '~':
+		goto yy221
+	default:
+		goto yy217
+	}
+yy219:
+	cursor += 1
+//line "parser.re":140
+	{ cursor--; goto endParams }
+//line "parser_re.go":3349
+yy220:
+	yyaccept = 1
+	cursor += 1
+	marker = cursor
+	yych = peek(str, cursor, limit)
+yy221:
+	switch (yych) {
+	case '!':
 		fallthrough
-	case 'X':
+	case '$':
 		fallthrough
-	case 'Y':
+	case '&','\'','(',')','*','+':
 		fallthrough
-	case 'Z':
+	case '-','.','/','0','1','2','3','4','5','6','7','8','9',':':
 		fallthrough
-	case '[':
+	case 'A','B','C','D','E','F','G','H','I','J','K','L','M','N','O','P','Q','R','S','T','U','V','W','X','Y','Z','[':
 		fallthrough
 	case ']':
 		fallthrough
 	case '_':
 		fallthrough
-	case 'a':
+	case 'a','b','c','d','e','f','g','h','i','j','k','l','m','n','o','p','q','r','s','t','u','v','w','x','y','z':
 		fallthrough
-	case 'b':
-		fallthrough
-	case 'c':
-		fallthrough
-	case 'd':
+	case '~':
+		goto yy220
+	case '%':
+		goto yy223
+	case '=':
+		yyt1 = cursor
+		goto yy225
+	default:
+		yyt1 = cursor
+		goto yy222
+	}
+yy222:
+	ne = yyt1
+//line "parser.re":136
+	{
+		uri.spans.add(ne-ts, cursor-ts)
+		goto params
+	}
+//line "parser_re.go":3391
+yy223:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'e':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'f':
+	case 'a','b','c','d','e','f':
+		goto yy226
+	default:
+		goto yy224
+	}
+yy224:
+	cursor = marker
+	switch (yyaccept) {
+	case 0:
+		goto yy217
+	case 1:
+		yyt1 = cursor
+		goto yy222
+	default:
+		goto yy222
+	}
+yy225:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '!':
 		fallthrough
-	case 'g':
+	case '$','%','&','\'','(',')','*','+':
 		fallthrough
-	case 'h':
+	case '-','.','/','0','1','2','3','4','5','6','7','8','9',':':
 		fallthrough
-	case 'i':
+	case 'A','B','C','D','E','F','G','H','I','J','K','L','M','N','O','P','Q','R','S','T','U','V','W','X','Y','Z','[':
 		fallthrough
-	case 'j':
+	case ']':
 		fallthrough
-	case 'k':
+	case '_':
 		fallthrough
-	case 'l':
+	case 'a','b','c','d','e','f','g','h','i','j','k','l','m','n','o','p','q','r','s','t','u','v','w','x','y','z':
 		fallthrough
-	case 'm':
+	case '~':
+		goto yy228
+	default:
+		goto yy224
+	}
+yy226:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'n':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'o':
+	case 'a','b','c','d','e','f':
+		goto yy220
+	default:
+		goto yy224
+	}
+yy227:
+	yyaccept = 2
+	cursor += 1
+	marker = cursor
+	yych = peek(str, cursor, limit)
+yy228:
+	switch (yych) {
+	case '!':
 		fallthrough
-	case 'p':
+	case '$':
 		fallthrough
-	case 'q':
+	case '&','\'','(',')','*','+':
 		fallthrough
-	case 'r':
+	case '-','.','/','0','1','2','3','4','5','6','7','8','9',':':
 		fallthrough
-	case 's':
+	case 'A','B','C','D','E','F','G','H','I','J','K','L','M','N','O','P','Q','R','S','T','U','V','W','X','Y','Z','[':
 		fallthrough
-	case 't':
+	case ']':
 		fallthrough
-	case 'u':
+	case '_':
 		fallthrough
-	case 'v':
+	case 'a','b','c','d','e','f','g','h','i','j','k','l','m','n','o','p','q','r','s','t','u','v','w','x','y','z':
 		fallthrough
-	case 'w':
+	case '~':
+		goto yy227
+	case '%':
+		goto yy229
+	default:
+		goto yy222
+	}
+yy229:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'x':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'y':
+	case 'a','b','c','d','e','f':
+		goto yy230
+	default:
+		goto yy224
+	}
+yy230:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'z':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case '~':
-		goto yy132
-	case '%':
-		goto yy136
-	case '&':
-		goto yy137
+	case 'a','b','c','d','e','f':
+		goto yy227
 	default:
-		goto yy134
+		goto yy224
 	}
-yy134:
-	ts = yyt1
-	te = cursor
-//line "parser.re":104
-	{
-		uri.headers = str[ts:te]
-		goto done
+yy231:
+//line "parser.re":135
+	{ goto endParams }
+//line "parser_re.go":3511
+}
+//line "parser.re":141
+
+endParams:
+	if cursor > ts {
+		uri.params = str[ts:cursor]
 	}
-//line "parser_re.go":6233
-yy135:
+	
+//line "parser_re.go":3520
+{
+	var yych byte
+	yyaccept := 0
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '?':
+		goto yy235
+	default:
+		if (cursor >= limit) {
+			goto yy245
+		}
+		goto yy233
+	}
+yy233:
+	cursor += 1
+yy234:
+//line "parser.re":147
+	{ cursor--; err(); goto fail }
+//line "parser_re.go":3539
+yy235:
+	yyaccept = 0
 	cursor += 1
+	marker = cursor
 	yych = peek(str, cursor, limit)
 	switch (yych) {
-	case '0':
-		fallthrough
-	case '1':
+	case '!':
 		fallthrough
-	case '2':
+	case '$':
 		fallthrough
-	case '3':
+	case '\'','(',')','*','+':
 		fallthrough
-	case '4':
+	case '-','.','/','0','1','2','3','4','5','6','7','8','9',':':
 		fallthrough
-	case '5':
+	case '?':
 		fallthrough
-	case '6':
+	case 'A','B','C','D','E','F','G','H','I','J','K','L','M','N','O','P','Q','R','S','T','U','V','W','X','Y','Z','[':
 		fallthrough
-	case '7':
+	case ']':
 		fallthrough
-	case '8':
+	case '_':
 		fallthrough
-	case '9':
+	case 'a','b','c','d','e','f','g','h','i','j','k','l','m','n','o','p','q','r','s','t','u','v','w','x','y','z':
 		fallthrough
-	case 'A':
+	case '~':
+		yyt1 = cursor
+		goto yy236
+	case '%':
+		yyt1 = cursor
+		goto yy238
+	default:
+		goto yy234
+	}
+yy236:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '!':
 		fallthrough
-	case 'B':
+	case '$':
 		fallthrough
-	case 'C':
+	case '\'','(',')','*','+':
 		fallthrough
-	case 'D':
+	case '-','.','/','0','1','2','3','4','5','6','7','8','9',':':
 		fallthrough
-	case 'E':
+	case '?':
 		fallthrough
-	case 'F':
+	case 'A','B','C','D','E','F','G','H','I','J','K','L','M','N','O','P','Q','R','S','T','U','V','W','X','Y','Z','[':
 		fallthrough
-	case 'a':
+	case ']':
 		fallthrough
-	case 'b':
+	case '_':
 		fallthrough
-	case 'c':
+	case 'a','b','c','d','e','f','g','h','i','j','k','l','m','n','o','p','q','r','s','t','u','v','w','x','y','z':
 		fallthrough
-	case 'd':
+	case '~':
+		goto yy236
+	case '%':
+		goto yy238
+	case '=':
+		goto yy239
+	default:
+		goto yy237
+	}
+yy237:
+	cursor = marker
+	if (yyaccept == 0) {
+		goto yy234
+	} else {
+		goto yy240
+	}
+yy238:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'e':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'f':
-		goto yy128
+	case 'a','b','c','d','e','f':
+		goto yy241
 	default:
-		goto yy130
+		goto yy237
 	}
-yy136:
+yy239:
+	yyaccept = 1
 	cursor += 1
+	marker = cursor
 	yych = peek(str, cursor, limit)
 	switch (yych) {
-	case '0':
+	case '!':
+		fallthrough
+	case '$':
 		fallthrough
-	case '1':
+	case '\'','(',')','*','+':
 		fallthrough
-	case '2':
+	case '-','.','/','0','1','2','3','4','5','6','7','8','9',':':
 		fallthrough
-	case '3':
+	case '?':
 		fallthrough
-	case '4':
+	case 'A','B','C','D','E','F','G','H','I','J','K','L','M','N','O','P','Q','R','S','T','U','V','W','X','Y','Z','[':
 		fallthrough
-	case '5':
+	case ']':
 		fallthrough
-	case '6':
+	case '_':
 		fallthrough
-	case '7':
+	case 'a','b','c','d','e','f','g','h','i','j','k','l','m','n','o','p','q','r','s','t','u','v','w','x','y','z':
 		fallthrough
-	case '8':
+	case '~':
+		goto yy239
+	case '%':
+		goto yy242
+	case '&':
+		goto yy243
+	default:
+		goto yy240
+	}
+yy240:
+	ts = yyt1
+	te = cursor
+//line "parser.re":149
+	{
+		uri.headers = str[ts:te]
+		goto done
+	}
+//line "parser_re.go":3665
+yy241:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case '9':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'A':
+	case 'a','b','c','d','e','f':
+		goto yy236
+	default:
+		goto yy237
+	}
+yy242:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'B':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'C':
+	case 'a','b','c','d','e','f':
+		goto yy244
+	default:
+		goto yy237
+	}
+yy243:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '!':
 		fallthrough
-	case 'D':
+	case '$':
 		fallthrough
-	case 'E':
+	case '\'','(',')','*','+':
 		fallthrough
-	case 'F':
+	case '-','.','/','0','1','2','3','4','5','6','7','8','9',':':
 		fallthrough
-	case 'a':
+	case '?':
 		fallthrough
-	case 'b':
+	case 'A','B','C','D','E','F','G','H','I','J','K','L','M','N','O','P','Q','R','S','T','U','V','W','X','Y','Z','[':
 		fallthrough
-	case 'c':
+	case ']':
 		fallthrough
-	case 'd':
+	case '_':
 		fallthrough
-	case 'e':
+	case 'a','b','c','d','e','f','g','h','i','j','k','l','m','n','o','p','q','r','s','t','u','v','w','x','y','z':
 		fallthrough
-	case 'f':
-		goto yy138
+	case '~':
+		goto yy236
+	case '%':
+		goto yy238
 	default:
-		goto yy130
+		goto yy237
 	}
-yy137:
+yy244:
 	cursor += 1
 	yych = peek(str, cursor, limit)
 	switch (yych) {
-	case '!':
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case '$':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case '\'':
+	case 'a','b','c','d','e','f':
+		goto yy239
+	default:
+		goto yy237
+	}
+yy245:
+//line "parser.re":148
+	{ goto done }
+//line "parser_re.go":3737
+}
+//line "parser.re":153
+
+
+// rfc3966 tel URI, number is stored as userinfo
+number:
+	
+//line "parser_re.go":3745
+{
+	var yych byte
+	yyaccept := 0
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '%':
+		yyt1 = cursor
+		goto yy249
+	case '(',')':
 		fallthrough
-	case '(':
+	case '-','.':
+		yyt1 = cursor
+		goto yy250
+	case '*':
 		fallthrough
-	case ')':
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case '*':
+	case 'A','B','C','D','E','F':
 		fallthrough
+	case 'a','b','c','d','e','f':
+		yyt1 = cursor
+		goto yy251
 	case '+':
+		yyt1 = cursor
+		goto yy253
+	default:
+		if (cursor >= limit) {
+			goto yy262
+		}
+		goto yy247
+	}
+yy247:
+	cursor += 1
+yy248:
+//line "parser.re":158
+	{ cursor--; err(); goto fail }
+//line "parser_re.go":3782
+yy249:
+	yyaccept = 0
+	cursor += 1
+	marker = cursor
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '2':
+		goto yy254
+	default:
+		goto yy248
+	}
+yy250:
+	yyaccept = 0
+	cursor += 1
+	marker = cursor
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '%':
 		fallthrough
-	case '-':
-		fallthrough
-	case '.':
+	case '(',')','*':
 		fallthrough
-	case '/':
+	case '-','.':
 		fallthrough
-	case '0':
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case '1':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case '2':
+	case 'a','b','c','d','e','f':
+		goto yy258
+	default:
+		goto yy248
+	}
+yy251:
+	yyaccept = 1
+	cursor += 1
+	marker = cursor
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '%':
+		goto yy256
+	case '(',')','*':
 		fallthrough
-	case '3':
+	case '-','.':
 		fallthrough
-	case '4':
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case '5':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case '6':
+	case 'a','b','c','d','e','f':
+		goto yy251
+	default:
+		goto yy252
+	}
+yy252:
+	ts = yyt1
+	te = cursor
+//line "parser.re":166
+	{
+		uri.userinfo = str[ts:te]
+		ts = cursor + 1
+		goto telParams
+	}
+//line "parser_re.go":3845
+yy253:
+	yyaccept = 0
+	cursor += 1
+	marker = cursor
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '(',')':
 		fallthrough
-	case '7':
+	case '-','.':
+		goto yy259
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy260
+	default:
+		goto yy248
+	}
+yy254:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '3':
+		goto yy251
+	default:
+		goto yy255
+	}
+yy255:
+	cursor = marker
+	if (yyaccept == 0) {
+		goto yy248
+	} else {
+		goto yy252
+	}
+yy256:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '2':
+		goto yy254
+	default:
+		goto yy255
+	}
+yy257:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+yy258:
+	switch (yych) {
+	case '%':
+		goto yy256
+	case '(',')':
 		fallthrough
-	case '8':
+	case '-','.':
+		goto yy257
+	case '*':
 		fallthrough
-	case '9':
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case ':':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case '?':
+	case 'a','b','c','d','e','f':
+		goto yy251
+	default:
+		goto yy255
+	}
+yy259:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '(',')':
 		fallthrough
-	case 'A':
+	case '-','.':
+		goto yy259
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy260
+	default:
+		goto yy255
+	}
+yy260:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '(',')':
 		fallthrough
-	case 'B':
+	case '-','.':
 		fallthrough
-	case 'C':
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy260
+	default:
+		goto yy261
+	}
+yy261:
+	ts = yyt1
+	te = cursor
+//line "parser.re":160
+	{
+		global = true
+		uri.userinfo = str[ts:te]
+		ts = cursor + 1
+		goto telParams
+	}
+//line "parser_re.go":3944
+yy262:
+//line "parser.re":159
+	{ err(); goto fail }
+//line "parser_re.go":3948
+}
+//line "parser.re":171
+
+telParams:
+	
+//line "parser_re.go":3954
+{
+	var yych byte
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case ';':
+		goto yy266
+	default:
+		if (cursor >= limit) {
+			goto yy269
+		}
+		goto yy264
+	}
+yy264:
+	cursor += 1
+yy265:
+//line "parser.re":174
+	{ cursor--; err(); goto fail }
+//line "parser_re.go":3972
+yy266:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '-':
 		fallthrough
-	case 'D':
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'E':
+	case 'A','B','C','D','E','F','G','H','I','J','K','L','M','N','O','P','Q','R','S','T','U','V','W','X','Y','Z':
 		fallthrough
-	case 'F':
+	case 'a','b','c','d','e','f','g','h','i','j','k','l','m','n','o','p','q','r','s','t','u','v','w','x','y','z':
+		yyt1 = cursor
+		goto yy267
+	default:
+		goto yy265
+	}
+yy267:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '-':
 		fallthrough
-	case 'G':
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'H':
+	case 'A','B','C','D','E','F','G','H','I','J','K','L','M','N','O','P','Q','R','S','T','U','V','W','X','Y','Z':
 		fallthrough
-	case 'I':
+	case 'a','b','c','d','e','f','g','h','i','j','k','l','m','n','o','p','q','r','s','t','u','v','w','x','y','z':
+		goto yy267
+	default:
+		goto yy268
+	}
+yy268:
+	ns = yyt1
+	ne = cursor
+//line "parser.re":176
+	{
+		switch strings.ToLower(str[ns:ne]) {
+		case "isub":
+			if isub {
+				goto invalidTelParam
+			}
+			isub = true
+			goto isubValue
+		case "ext":
+			if ext {
+				goto invalidTelParam
+			}
+			ext = true
+			goto extValue
+		case "postd":
+			if postd {
+				goto invalidTelParam
+			}
+			postd = true
+			goto postdValue
+		case "phone-context":
+			if global || context {
+				goto invalidTelParam
+			}
+			context = true
+			goto contextValue
+		}
+		goto telValue
+	}
+//line "parser_re.go":4037
+yy269:
+//line "parser.re":175
+	{ goto endTel }
+//line "parser_re.go":4041
+}
+//line "parser.re":205
+
+isubValue:
+	
+//line "parser_re.go":4047
+{
+	var yych byte
+	yyaccept := 0
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '=':
+		goto yy273
+	default:
+		if (cursor >= limit) {
+			goto yy280
+		}
+		goto yy271
+	}
+yy271:
+	cursor += 1
+yy272:
+//line "parser.re":208
+	{ cursor--; err(); goto fail }
+//line "parser_re.go":4066
+yy273:
+	yyaccept = 0
+	cursor += 1
+	marker = cursor
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '!':
 		fallthrough
-	case 'J':
+	case '$','%','&','\'','(',')','*','+',',','-','.','/','0','1','2','3','4','5','6','7','8','9',':':
 		fallthrough
-	case 'K':
+	case '=':
 		fallthrough
-	case 'L':
+	case '?','@','A','B','C','D','E','F','G','H','I','J','K','L','M','N','O','P','Q','R','S','T','U','V','W','X','Y','Z':
 		fallthrough
-	case 'M':
+	case '_':
 		fallthrough
-	case 'N':
+	case 'a','b','c','d','e','f','g','h','i','j','k','l','m','n','o','p','q','r','s','t','u','v','w','x','y','z':
 		fallthrough
-	case 'O':
+	case '~':
+		goto yy275
+	default:
+		goto yy272
+	}
+yy274:
+	yyaccept = 1
+	cursor += 1
+	marker = cursor
+	yych = peek(str, cursor, limit)
+yy275:
+	switch (yych) {
+	case '!':
 		fallthrough
-	case 'P':
+	case '$':
 		fallthrough
-	case 'Q':
+	case '&','\'','(',')','*','+',',','-','.','/','0','1','2','3','4','5','6','7','8','9',':':
 		fallthrough
-	case 'R':
+	case '=':
 		fallthrough
-	case 'S':
+	case '?','@','A','B','C','D','E','F','G','H','I','J','K','L','M','N','O','P','Q','R','S','T','U','V','W','X','Y','Z':
 		fallthrough
-	case 'T':
+	case '_':
 		fallthrough
-	case 'U':
+	case 'a','b','c','d','e','f','g','h','i','j','k','l','m','n','o','p','q','r','s','t','u','v','w','x','y','z':
 		fallthrough
-	case 'V':
+	case '~':
+		goto yy274
+	case '%':
+		goto yy277
+	default:
+		goto yy276
+	}
+yy276:
+//line "parser.re":210
+	{ goto telParam }
+//line "parser_re.go":4121
+yy277:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'W':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'X':
+	case 'a','b','c','d','e','f':
+		goto yy279
+	default:
+		goto yy278
+	}
+yy278:
+	cursor = marker
+	if (yyaccept == 0) {
+		goto yy272
+	} else {
+		goto yy276
+	}
+yy279:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'Y':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'Z':
+	case 'a','b','c','d','e','f':
+		goto yy274
+	default:
+		goto yy278
+	}
+yy280:
+//line "parser.re":209
+	{ err(); goto fail }
+//line "parser_re.go":4158
+}
+//line "parser.re":211
+
+extValue:
+	
+//line "parser_re.go":4164
+{
+	var yych byte
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '=':
+		goto yy284
+	default:
+		if (cursor >= limit) {
+			goto yy289
+		}
+		goto yy282
+	}
+yy282:
+	cursor += 1
+yy283:
+//line "parser.re":214
+	{ cursor--; err(); goto fail }
+//line "parser_re.go":4182
+yy284:
+	cursor += 1
+	marker = cursor
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '(',')':
 		fallthrough
-	case '[':
+	case '-','.':
+		goto yy285
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy287
+	default:
+		goto yy283
+	}
+yy285:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '(',')':
 		fallthrough
-	case ']':
+	case '-','.':
+		goto yy285
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy287
+	default:
+		goto yy286
+	}
+yy286:
+	cursor = marker
+	goto yy283
+yy287:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '(',')':
 		fallthrough
-	case '_':
+	case '-','.':
 		fallthrough
-	case 'a':
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy287
+	default:
+		goto yy288
+	}
+yy288:
+//line "parser.re":216
+	{ goto telParam }
+//line "parser_re.go":4229
+yy289:
+//line "parser.re":215
+	{ err(); goto fail }
+//line "parser_re.go":4233
+}
+//line "parser.re":217
+
+postdValue:
+	
+//line "parser_re.go":4239
+{
+	var yych byte
+	yyaccept := 0
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '=':
+		goto yy293
+	default:
+		if (cursor >= limit) {
+			goto yy299
+		}
+		goto yy291
+	}
+yy291:
+	cursor += 1
+yy292:
+//line "parser.re":220
+	{ cursor--; err(); goto fail }
+//line "parser_re.go":4258
+yy293:
+	yyaccept = 0
+	cursor += 1
+	marker = cursor
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '%':
+		goto yy294
+	case '(',')','*':
 		fallthrough
-	case 'b':
+	case '-','.':
 		fallthrough
-	case 'c':
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'd':
+	case 'A','B','C','D':
 		fallthrough
-	case 'e':
+	case 'P':
 		fallthrough
-	case 'f':
+	case 'W':
 		fallthrough
-	case 'g':
+	case 'a','b','c','d':
 		fallthrough
-	case 'h':
+	case 'p':
 		fallthrough
-	case 'i':
+	case 'w':
+		goto yy296
+	default:
+		goto yy292
+	}
+yy294:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '2':
+		goto yy298
+	default:
+		goto yy295
+	}
+yy295:
+	cursor = marker
+	if (yyaccept == 0) {
+		goto yy292
+	} else {
+		goto yy297
+	}
+yy296:
+	yyaccept = 1
+	cursor += 1
+	marker = cursor
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '%':
+		goto yy294
+	case '(',')','*':
 		fallthrough
-	case 'j':
+	case '-','.':
 		fallthrough
-	case 'k':
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'l':
+	case 'A','B','C','D':
 		fallthrough
-	case 'm':
+	case 'P':
 		fallthrough
-	case 'n':
+	case 'W':
 		fallthrough
-	case 'o':
+	case 'a','b','c','d':
 		fallthrough
 	case 'p':
 		fallthrough
-	case 'q':
+	case 'w':
+		goto yy296
+	default:
+		goto yy297
+	}
+yy297:
+//line "parser.re":222
+	{ goto telParam }
+//line "parser_re.go":4336
+yy298:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '3':
+		goto yy296
+	default:
+		goto yy295
+	}
+yy299:
+//line "parser.re":221
+	{ err(); goto fail }
+//line "parser_re.go":4349
+}
+//line "parser.re":223
+
+contextValue:
+	
+//line "parser_re.go":4355
+{
+	var yych byte
+	yyaccept := 0
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '=':
+		goto yy303
+	default:
+		if (cursor >= limit) {
+			goto yy314
+		}
+		goto yy301
+	}
+yy301:
+	cursor += 1
+yy302:
+//line "parser.re":226
+	{ cursor--; err(); goto fail }
+//line "parser_re.go":4374
+yy303:
+	yyaccept = 0
+	cursor += 1
+	marker = cursor
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '+':
+		goto yy304
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy306
+	case 'A','B','C','D','E','F','G','H','I','J','K','L','M','N','O','P','Q','R','S','T','U','V','W','X','Y','Z':
+		fallthrough
+	case 'a','b','c','d','e','f','g','h','i','j','k','l','m','n','o','p','q','r','s','t','u','v','w','x','y','z':
+		goto yy307
+	default:
+		goto yy302
+	}
+yy304:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '(',')':
 		fallthrough
-	case 'r':
+	case '-','.':
+		goto yy304
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy309
+	default:
+		goto yy305
+	}
+yy305:
+	cursor = marker
+	if (yyaccept == 0) {
+		goto yy302
+	} else {
+		goto yy308
+	}
+yy306:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '-':
+		goto yy310
+	case '.':
+		goto yy311
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 's':
+	case 'A','B','C','D','E','F','G','H','I','J','K','L','M','N','O','P','Q','R','S','T','U','V','W','X','Y','Z':
 		fallthrough
-	case 't':
+	case 'a','b','c','d','e','f','g','h','i','j','k','l','m','n','o','p','q','r','s','t','u','v','w','x','y','z':
+		goto yy306
+	default:
+		goto yy305
+	}
+yy307:
+	yyaccept = 1
+	cursor += 1
+	marker = cursor
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '-':
+		goto yy312
+	case '.':
+		goto yy313
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'u':
+	case 'A','B','C','D','E','F','G','H','I','J','K','L','M','N','O','P','Q','R','S','T','U','V','W','X','Y','Z':
 		fallthrough
-	case 'v':
+	case 'a','b','c','d','e','f','g','h','i','j','k','l','m','n','o','p','q','r','s','t','u','v','w','x','y','z':
+		goto yy307
+	default:
+		goto yy308
+	}
+yy308:
+//line "parser.re":228
+	{ goto telParam }
+//line "parser_re.go":4451
+yy309:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '(',')':
 		fallthrough
-	case 'w':
+	case '-','.':
 		fallthrough
-	case 'x':
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy309
+	default:
+		goto yy308
+	}
+yy310:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '-':
+		goto yy310
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'y':
+	case 'A','B','C','D','E','F','G','H','I','J','K','L','M','N','O','P','Q','R','S','T','U','V','W','X','Y','Z':
 		fallthrough
-	case 'z':
+	case 'a','b','c','d','e','f','g','h','i','j','k','l','m','n','o','p','q','r','s','t','u','v','w','x','y','z':
+		goto yy306
+	default:
+		goto yy305
+	}
+yy311:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy306
+	case 'A','B','C','D','E','F','G','H','I','J','K','L','M','N','O','P','Q','R','S','T','U','V','W','X','Y','Z':
 		fallthrough
-	case '~':
-		goto yy128
-	case '%':
-		goto yy131
+	case 'a','b','c','d','e','f','g','h','i','j','k','l','m','n','o','p','q','r','s','t','u','v','w','x','y','z':
+		goto yy307
 	default:
-		goto yy130
+		goto yy305
 	}
-yy138:
+yy312:
 	cursor += 1
 	yych = peek(str, cursor, limit)
 	switch (yych) {
-	case '0':
+	case '-':
+		goto yy312
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case '1':
+	case 'A','B','C','D','E','F','G','H','I','J','K','L','M','N','O','P','Q','R','S','T','U','V','W','X','Y','Z':
 		fallthrough
-	case '2':
+	case 'a','b','c','d','e','f','g','h','i','j','k','l','m','n','o','p','q','r','s','t','u','v','w','x','y','z':
+		goto yy307
+	default:
+		goto yy305
+	}
+yy313:
+	yyaccept = 1
+	cursor += 1
+	marker = cursor
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
+		goto yy306
+	case 'A','B','C','D','E','F','G','H','I','J','K','L','M','N','O','P','Q','R','S','T','U','V','W','X','Y','Z':
 		fallthrough
-	case '3':
+	case 'a','b','c','d','e','f','g','h','i','j','k','l','m','n','o','p','q','r','s','t','u','v','w','x','y','z':
+		goto yy307
+	default:
+		goto yy308
+	}
+yy314:
+//line "parser.re":227
+	{ err(); goto fail }
+//line "parser_re.go":4526
+}
+//line "parser.re":229
+
+telValue:
+	
+//line "parser_re.go":4532
+{
+	var yych byte
+	yyaccept := 0
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '=':
+		goto yy318
+	default:
+		if (cursor >= limit) {
+			goto yy325
+		}
+		goto yy316
+	}
+yy316:
+	cursor += 1
+yy317:
+//line "parser.re":232
+	{ cursor--; goto telParam }
+//line "parser_re.go":4551
+yy318:
+	yyaccept = 0
+	cursor += 1
+	marker = cursor
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '!':
 		fallthrough
-	case '4':
+	case '$','%','&','\'','(',')','*','+':
 		fallthrough
-	case '5':
+	case '-','.','/','0','1','2','3','4','5','6','7','8','9',':':
 		fallthrough
-	case '6':
+	case 'A','B','C','D','E','F','G','H','I','J','K','L','M','N','O','P','Q','R','S','T','U','V','W','X','Y','Z','[':
+		fallthrough
+	case ']':
 		fallthrough
-	case '7':
+	case '_':
 		fallthrough
-	case '8':
+	case 'a','b','c','d','e','f','g','h','i','j','k','l','m','n','o','p','q','r','s','t','u','v','w','x','y','z':
 		fallthrough
-	case '9':
+	case '~':
+		goto yy320
+	default:
+		goto yy317
+	}
+yy319:
+	yyaccept = 1
+	cursor += 1
+	marker = cursor
+	yych = peek(str, cursor, limit)
+yy320:
+	switch (yych) {
+	case '!':
 		fallthrough
-	case 'A':
+	case '$':
 		fallthrough
-	case 'B':
+	case '&','\'','(',')','*','+':
 		fallthrough
-	case 'C':
+	case '-','.','/','0','1','2','3','4','5','6','7','8','9',':':
 		fallthrough
-	case 'D':
+	case 'A','B','C','D','E','F','G','H','I','J','K','L','M','N','O','P','Q','R','S','T','U','V','W','X','Y','Z','[':
 		fallthrough
-	case 'E':
+	case ']':
 		fallthrough
-	case 'F':
+	case '_':
 		fallthrough
-	case 'a':
+	case 'a','b','c','d','e','f','g','h','i','j','k','l','m','n','o','p','q','r','s','t','u','v','w','x','y','z':
 		fallthrough
-	case 'b':
+	case '~':
+		goto yy319
+	case '%':
+		goto yy322
+	default:
+		goto yy321
+	}
+yy321:
+//line "parser.re":234
+	{ goto telParam }
+//line "parser_re.go":4610
+yy322:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'c':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'd':
+	case 'a','b','c','d','e','f':
+		goto yy324
+	default:
+		goto yy323
+	}
+yy323:
+	cursor = marker
+	if (yyaccept == 0) {
+		goto yy317
+	} else {
+		goto yy321
+	}
+yy324:
+	cursor += 1
+	yych = peek(str, cursor, limit)
+	switch (yych) {
+	case '0','1','2','3','4','5','6','7','8','9':
 		fallthrough
-	case 'e':
+	case 'A','B','C','D','E','F':
 		fallthrough
-	case 'f':
-		goto yy132
+	case 'a','b','c','d','e','f':
+		goto yy319
 	default:
-		goto yy130
+		goto yy323
 	}
-yy139:
-//line "parser.re":103
-	{ goto done }
-//line "parser_re.go":6555
+yy325:
+//line "parser.re":233
+	{ goto telParam }
+//line "parser_re.go":4647
 }
-//line "parser.re":108
+//line "parser.re":235
 
+telParam:
+	uri.spans.add(ne-ts, cursor-ts)
+	goto telParams
+invalidTelParam:
+	// duplicate parameter fails at "="
+	reach = ne
+	err()
+	goto fail
+endTel:
+	if !global && !context {
+		err()
+		goto fail
+	}
+	if cursor > ts {
+		uri.params = str[ts:cursor]
+	}
+	return uri, nil
 
 fail:
 	return nil, parseError
 
 done:
+	if cursor < limit {
+		err()
+		goto fail
+	}
 	return uri, nil
 }
 
@@ -63,6 +63,7 @@ func TestRe2GoParseURIFail(t *testing.T) {
 		{"sip:atlanta.com;foo?bar", "invalid headers"},
 		{"sip:;foo?bar", "invalid host"},
 		{"sip:?foo", "invalid host"},
+		{"sip:atlanta.com?a=b c", "invalid headers"},
 	}
 
 	for _, tc := range tests {
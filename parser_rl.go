@@ -3,7 +3,7 @@
 package uri
 
 import (
-	"fmt"
+	"strings"
 )
 
 
@@ -11,10 +11,11 @@ import (
 
 //line parser_rl.go:13
 const uri_start int = 1
-const uri_first_final int = 120
+const uri_first_final int = 263
 const uri_error int = 0
 
-const uri_en_uri int = 1
+const uri_en_main int = 1
+const uri_en_hostpart int = 82
 
 
 //line parser.rl:9
@@ -26,24 +27,647 @@ func RagelParse(str string) (*URI, error) {
 	limit := len(data)
 	p := 0 // data pointer
 	m := 0 // marker for matching start position
+	u := 0 // userinfo start position
+	e := 0 // parameter name end position
+	o := 0 // port start position
+	isub, ext, postd := false, false, false
+	port := 0 // port value
 	pe := limit // data end pointer
 	eof := limit // End of data
 
-//line parser.rl:59
+//line parser.rl:120
 
   
-//line parser_rl.go:36
+//line parser_rl.go:42
 	{
 	cs = uri_start
 	}
 
-//line parser.rl:61
+//line parser.rl:122
 	
-//line parser_rl.go:43
+//line parser_rl.go:49
 	{
 	if p == pe {
 		goto _test_eof
 	}
+	goto _resume
+
+_again:
+	switch cs {
+	case 1:
+		goto st1
+	case 0:
+		goto st0
+	case 2:
+		goto st2
+	case 3:
+		goto st3
+	case 4:
+		goto st4
+	case 5:
+		goto st5
+	case 6:
+		goto st6
+	case 7:
+		goto st7
+	case 8:
+		goto st8
+	case 9:
+		goto st9
+	case 10:
+		goto st10
+	case 11:
+		goto st11
+	case 12:
+		goto st12
+	case 13:
+		goto st13
+	case 14:
+		goto st14
+	case 15:
+		goto st15
+	case 16:
+		goto st16
+	case 17:
+		goto st17
+	case 18:
+		goto st18
+	case 19:
+		goto st19
+	case 20:
+		goto st20
+	case 21:
+		goto st21
+	case 22:
+		goto st22
+	case 23:
+		goto st23
+	case 24:
+		goto st24
+	case 25:
+		goto st25
+	case 26:
+		goto st26
+	case 27:
+		goto st27
+	case 28:
+		goto st28
+	case 29:
+		goto st29
+	case 30:
+		goto st30
+	case 31:
+		goto st31
+	case 32:
+		goto st32
+	case 33:
+		goto st33
+	case 34:
+		goto st34
+	case 35:
+		goto st35
+	case 36:
+		goto st36
+	case 37:
+		goto st37
+	case 38:
+		goto st38
+	case 39:
+		goto st39
+	case 40:
+		goto st40
+	case 41:
+		goto st41
+	case 42:
+		goto st42
+	case 263:
+		goto st263
+	case 43:
+		goto st43
+	case 264:
+		goto st264
+	case 44:
+		goto st44
+	case 265:
+		goto st265
+	case 45:
+		goto st45
+	case 46:
+		goto st46
+	case 266:
+		goto st266
+	case 267:
+		goto st267
+	case 47:
+		goto st47
+	case 268:
+		goto st268
+	case 269:
+		goto st269
+	case 270:
+		goto st270
+	case 48:
+		goto st48
+	case 49:
+		goto st49
+	case 271:
+		goto st271
+	case 50:
+		goto st50
+	case 51:
+		goto st51
+	case 272:
+		goto st272
+	case 273:
+		goto st273
+	case 274:
+		goto st274
+	case 275:
+		goto st275
+	case 276:
+		goto st276
+	case 277:
+		goto st277
+	case 278:
+		goto st278
+	case 279:
+		goto st279
+	case 280:
+		goto st280
+	case 281:
+		goto st281
+	case 282:
+		goto st282
+	case 283:
+		goto st283
+	case 52:
+		goto st52
+	case 284:
+		goto st284
+	case 285:
+		goto st285
+	case 286:
+		goto st286
+	case 53:
+		goto st53
+	case 54:
+		goto st54
+	case 55:
+		goto st55
+	case 56:
+		goto st56
+	case 287:
+		goto st287
+	case 57:
+		goto st57
+	case 58:
+		goto st58
+	case 59:
+		goto st59
+	case 288:
+		goto st288
+	case 60:
+		goto st60
+	case 289:
+		goto st289
+	case 61:
+		goto st61
+	case 62:
+		goto st62
+	case 63:
+		goto st63
+	case 64:
+		goto st64
+	case 65:
+		goto st65
+	case 66:
+		goto st66
+	case 67:
+		goto st67
+	case 68:
+		goto st68
+	case 69:
+		goto st69
+	case 70:
+		goto st70
+	case 290:
+		goto st290
+	case 71:
+		goto st71
+	case 72:
+		goto st72
+	case 73:
+		goto st73
+	case 74:
+		goto st74
+	case 75:
+		goto st75
+	case 76:
+		goto st76
+	case 77:
+		goto st77
+	case 78:
+		goto st78
+	case 79:
+		goto st79
+	case 80:
+		goto st80
+	case 291:
+		goto st291
+	case 81:
+		goto st81
+	case 82:
+		goto st82
+	case 83:
+		goto st83
+	case 84:
+		goto st84
+	case 85:
+		goto st85
+	case 86:
+		goto st86
+	case 292:
+		goto st292
+	case 87:
+		goto st87
+	case 293:
+		goto st293
+	case 88:
+		goto st88
+	case 294:
+		goto st294
+	case 89:
+		goto st89
+	case 295:
+		goto st295
+	case 90:
+		goto st90
+	case 91:
+		goto st91
+	case 92:
+		goto st92
+	case 296:
+		goto st296
+	case 93:
+		goto st93
+	case 94:
+		goto st94
+	case 95:
+		goto st95
+	case 96:
+		goto st96
+	case 97:
+		goto st97
+	case 98:
+		goto st98
+	case 297:
+		goto st297
+	case 99:
+		goto st99
+	case 100:
+		goto st100
+	case 101:
+		goto st101
+	case 102:
+		goto st102
+	case 103:
+		goto st103
+	case 104:
+		goto st104
+	case 105:
+		goto st105
+	case 106:
+		goto st106
+	case 298:
+		goto st298
+	case 299:
+		goto st299
+	case 300:
+		goto st300
+	case 301:
+		goto st301
+	case 302:
+		goto st302
+	case 107:
+		goto st107
+	case 108:
+		goto st108
+	case 109:
+		goto st109
+	case 110:
+		goto st110
+	case 111:
+		goto st111
+	case 112:
+		goto st112
+	case 113:
+		goto st113
+	case 114:
+		goto st114
+	case 115:
+		goto st115
+	case 116:
+		goto st116
+	case 117:
+		goto st117
+	case 118:
+		goto st118
+	case 119:
+		goto st119
+	case 120:
+		goto st120
+	case 121:
+		goto st121
+	case 122:
+		goto st122
+	case 123:
+		goto st123
+	case 124:
+		goto st124
+	case 125:
+		goto st125
+	case 126:
+		goto st126
+	case 127:
+		goto st127
+	case 128:
+		goto st128
+	case 129:
+		goto st129
+	case 130:
+		goto st130
+	case 131:
+		goto st131
+	case 132:
+		goto st132
+	case 133:
+		goto st133
+	case 134:
+		goto st134
+	case 135:
+		goto st135
+	case 136:
+		goto st136
+	case 137:
+		goto st137
+	case 138:
+		goto st138
+	case 139:
+		goto st139
+	case 140:
+		goto st140
+	case 141:
+		goto st141
+	case 142:
+		goto st142
+	case 143:
+		goto st143
+	case 144:
+		goto st144
+	case 145:
+		goto st145
+	case 146:
+		goto st146
+	case 147:
+		goto st147
+	case 148:
+		goto st148
+	case 149:
+		goto st149
+	case 150:
+		goto st150
+	case 151:
+		goto st151
+	case 152:
+		goto st152
+	case 153:
+		goto st153
+	case 154:
+		goto st154
+	case 155:
+		goto st155
+	case 156:
+		goto st156
+	case 157:
+		goto st157
+	case 158:
+		goto st158
+	case 303:
+		goto st303
+	case 159:
+		goto st159
+	case 160:
+		goto st160
+	case 161:
+		goto st161
+	case 162:
+		goto st162
+	case 163:
+		goto st163
+	case 164:
+		goto st164
+	case 165:
+		goto st165
+	case 166:
+		goto st166
+	case 167:
+		goto st167
+	case 168:
+		goto st168
+	case 169:
+		goto st169
+	case 170:
+		goto st170
+	case 171:
+		goto st171
+	case 172:
+		goto st172
+	case 173:
+		goto st173
+	case 174:
+		goto st174
+	case 175:
+		goto st175
+	case 176:
+		goto st176
+	case 177:
+		goto st177
+	case 178:
+		goto st178
+	case 179:
+		goto st179
+	case 180:
+		goto st180
+	case 181:
+		goto st181
+	case 182:
+		goto st182
+	case 183:
+		goto st183
+	case 184:
+		goto st184
+	case 185:
+		goto st185
+	case 186:
+		goto st186
+	case 187:
+		goto st187
+	case 188:
+		goto st188
+	case 189:
+		goto st189
+	case 190:
+		goto st190
+	case 191:
+		goto st191
+	case 192:
+		goto st192
+	case 193:
+		goto st193
+	case 194:
+		goto st194
+	case 195:
+		goto st195
+	case 196:
+		goto st196
+	case 197:
+		goto st197
+	case 198:
+		goto st198
+	case 199:
+		goto st199
+	case 200:
+		goto st200
+	case 201:
+		goto st201
+	case 202:
+		goto st202
+	case 203:
+		goto st203
+	case 204:
+		goto st204
+	case 205:
+		goto st205
+	case 206:
+		goto st206
+	case 207:
+		goto st207
+	case 208:
+		goto st208
+	case 209:
+		goto st209
+	case 210:
+		goto st210
+	case 211:
+		goto st211
+	case 212:
+		goto st212
+	case 213:
+		goto st213
+	case 214:
+		goto st214
+	case 215:
+		goto st215
+	case 216:
+		goto st216
+	case 217:
+		goto st217
+	case 218:
+		goto st218
+	case 219:
+		goto st219
+	case 220:
+		goto st220
+	case 221:
+		goto st221
+	case 222:
+		goto st222
+	case 223:
+		goto st223
+	case 224:
+		goto st224
+	case 225:
+		goto st225
+	case 226:
+		goto st226
+	case 227:
+		goto st227
+	case 228:
+		goto st228
+	case 229:
+		goto st229
+	case 230:
+		goto st230
+	case 231:
+		goto st231
+	case 232:
+		goto st232
+	case 233:
+		goto st233
+	case 234:
+		goto st234
+	case 235:
+		goto st235
+	case 236:
+		goto st236
+	case 237:
+		goto st237
+	case 238:
+		goto st238
+	case 239:
+		goto st239
+	case 240:
+		goto st240
+	case 241:
+		goto st241
+	case 242:
+		goto st242
+	case 243:
+		goto st243
+	case 244:
+		goto st244
+	case 245:
+		goto st245
+	case 246:
+		goto st246
+	case 247:
+		goto st247
+	case 248:
+		goto st248
+	case 249:
+		goto st249
+	case 250:
+		goto st250
+	case 251:
+		goto st251
+	case 252:
+		goto st252
+	case 253:
+		goto st253
+	case 254:
+		goto st254
+	case 255:
+		goto st255
+	case 256:
+		goto st256
+	case 257:
+		goto st257
+	case 258:
+		goto st258
+	case 259:
+		goto st259
+	case 260:
+		goto st260
+	case 261:
+		goto st261
+	case 262:
+		goto st262
+	}
+
+	if p++; p == pe {
+		goto _test_eof
+	}
+_resume:
 	switch cs {
 	case 1:
 		goto st_case_1
@@ -79,36 +703,18 @@ func RagelParse(str string) (*URI, error) {
 		goto st_case_15
 	case 16:
 		goto st_case_16
-	case 120:
-		goto st_case_120
 	case 17:
 		goto st_case_17
-	case 121:
-		goto st_case_121
 	case 18:
 		goto st_case_18
-	case 122:
-		goto st_case_122
-	case 123:
-		goto st_case_123
-	case 124:
-		goto st_case_124
-	case 125:
-		goto st_case_125
-	case 126:
-		goto st_case_126
 	case 19:
 		goto st_case_19
-	case 127:
-		goto st_case_127
 	case 20:
 		goto st_case_20
 	case 21:
 		goto st_case_21
 	case 22:
 		goto st_case_22
-	case 128:
-		goto st_case_128
 	case 23:
 		goto st_case_23
 	case 24:
@@ -121,8 +727,6 @@ func RagelParse(str string) (*URI, error) {
 		goto st_case_27
 	case 28:
 		goto st_case_28
-	case 129:
-		goto st_case_129
 	case 29:
 		goto st_case_29
 	case 30:
@@ -139,12 +743,6 @@ func RagelParse(str string) (*URI, error) {
 		goto st_case_35
 	case 36:
 		goto st_case_36
-	case 130:
-		goto st_case_130
-	case 131:
-		goto st_case_131
-	case 132:
-		goto st_case_132
 	case 37:
 		goto st_case_37
 	case 38:
@@ -157,26 +755,74 @@ func RagelParse(str string) (*URI, error) {
 		goto st_case_41
 	case 42:
 		goto st_case_42
+	case 263:
+		goto st_case_263
 	case 43:
 		goto st_case_43
+	case 264:
+		goto st_case_264
 	case 44:
 		goto st_case_44
+	case 265:
+		goto st_case_265
 	case 45:
 		goto st_case_45
 	case 46:
 		goto st_case_46
+	case 266:
+		goto st_case_266
+	case 267:
+		goto st_case_267
 	case 47:
 		goto st_case_47
+	case 268:
+		goto st_case_268
+	case 269:
+		goto st_case_269
+	case 270:
+		goto st_case_270
 	case 48:
 		goto st_case_48
 	case 49:
 		goto st_case_49
+	case 271:
+		goto st_case_271
 	case 50:
 		goto st_case_50
 	case 51:
 		goto st_case_51
+	case 272:
+		goto st_case_272
+	case 273:
+		goto st_case_273
+	case 274:
+		goto st_case_274
+	case 275:
+		goto st_case_275
+	case 276:
+		goto st_case_276
+	case 277:
+		goto st_case_277
+	case 278:
+		goto st_case_278
+	case 279:
+		goto st_case_279
+	case 280:
+		goto st_case_280
+	case 281:
+		goto st_case_281
+	case 282:
+		goto st_case_282
+	case 283:
+		goto st_case_283
 	case 52:
 		goto st_case_52
+	case 284:
+		goto st_case_284
+	case 285:
+		goto st_case_285
+	case 286:
+		goto st_case_286
 	case 53:
 		goto st_case_53
 	case 54:
@@ -185,16 +831,20 @@ func RagelParse(str string) (*URI, error) {
 		goto st_case_55
 	case 56:
 		goto st_case_56
+	case 287:
+		goto st_case_287
 	case 57:
 		goto st_case_57
-	case 133:
-		goto st_case_133
 	case 58:
 		goto st_case_58
 	case 59:
 		goto st_case_59
+	case 288:
+		goto st_case_288
 	case 60:
 		goto st_case_60
+	case 289:
+		goto st_case_289
 	case 61:
 		goto st_case_61
 	case 62:
@@ -215,6 +865,8 @@ func RagelParse(str string) (*URI, error) {
 		goto st_case_69
 	case 70:
 		goto st_case_70
+	case 290:
+		goto st_case_290
 	case 71:
 		goto st_case_71
 	case 72:
@@ -235,52 +887,42 @@ func RagelParse(str string) (*URI, error) {
 		goto st_case_79
 	case 80:
 		goto st_case_80
+	case 291:
+		goto st_case_291
 	case 81:
 		goto st_case_81
-	case 134:
-		goto st_case_134
 	case 82:
 		goto st_case_82
-	case 135:
-		goto st_case_135
 	case 83:
 		goto st_case_83
-	case 136:
-		goto st_case_136
-	case 137:
-		goto st_case_137
-	case 138:
-		goto st_case_138
-	case 139:
-		goto st_case_139
-	case 140:
-		goto st_case_140
 	case 84:
 		goto st_case_84
-	case 141:
-		goto st_case_141
 	case 85:
 		goto st_case_85
 	case 86:
 		goto st_case_86
-	case 142:
-		goto st_case_142
+	case 292:
+		goto st_case_292
 	case 87:
 		goto st_case_87
+	case 293:
+		goto st_case_293
 	case 88:
 		goto st_case_88
+	case 294:
+		goto st_case_294
 	case 89:
 		goto st_case_89
-	case 143:
-		goto st_case_143
+	case 295:
+		goto st_case_295
 	case 90:
 		goto st_case_90
 	case 91:
 		goto st_case_91
 	case 92:
 		goto st_case_92
-	case 144:
-		goto st_case_144
+	case 296:
+		goto st_case_296
 	case 93:
 		goto st_case_93
 	case 94:
@@ -293,26 +935,34 @@ func RagelParse(str string) (*URI, error) {
 		goto st_case_97
 	case 98:
 		goto st_case_98
+	case 297:
+		goto st_case_297
 	case 99:
 		goto st_case_99
 	case 100:
 		goto st_case_100
 	case 101:
 		goto st_case_101
-	case 145:
-		goto st_case_145
 	case 102:
 		goto st_case_102
 	case 103:
 		goto st_case_103
 	case 104:
 		goto st_case_104
-	case 146:
-		goto st_case_146
 	case 105:
 		goto st_case_105
 	case 106:
 		goto st_case_106
+	case 298:
+		goto st_case_298
+	case 299:
+		goto st_case_299
+	case 300:
+		goto st_case_300
+	case 301:
+		goto st_case_301
+	case 302:
+		goto st_case_302
 	case 107:
 		goto st_case_107
 	case 108:
@@ -325,12 +975,6 @@ func RagelParse(str string) (*URI, error) {
 		goto st_case_111
 	case 112:
 		goto st_case_112
-	case 147:
-		goto st_case_147
-	case 148:
-		goto st_case_148
-	case 149:
-		goto st_case_149
 	case 113:
 		goto st_case_113
 	case 114:
@@ -345,99 +989,390 @@ func RagelParse(str string) (*URI, error) {
 		goto st_case_118
 	case 119:
 		goto st_case_119
-	}
-	goto st_out
-	st_case_1:
-		if data[p] == 115 {
-			goto st2
-		}
-		goto st0
-st_case_0:
-	st0:
-		cs = 0
-		goto _out
-	st2:
-		if p++; p == pe {
-			goto _test_eof2
-		}
-	st_case_2:
-		if data[p] == 105 {
-			goto st3
-		}
-		goto st0
-	st3:
-		if p++; p == pe {
-			goto _test_eof3
-		}
-	st_case_3:
-		if data[p] == 112 {
-			goto st4
-		}
-		goto st0
-	st4:
-		if p++; p == pe {
-			goto _test_eof4
-		}
-	st_case_4:
-		switch data[p] {
-		case 58:
-			goto tr4
-		case 115:
-			goto st119
-		}
-		goto st0
-tr4:
-//line parser.rl:21
- uri.scheme   = SIP      
-	goto st5
-tr128:
-//line parser.rl:22
- uri.scheme   = SIPS     
+	case 120:
+		goto st_case_120
+	case 121:
+		goto st_case_121
+	case 122:
+		goto st_case_122
+	case 123:
+		goto st_case_123
+	case 124:
+		goto st_case_124
+	case 125:
+		goto st_case_125
+	case 126:
+		goto st_case_126
+	case 127:
+		goto st_case_127
+	case 128:
+		goto st_case_128
+	case 129:
+		goto st_case_129
+	case 130:
+		goto st_case_130
+	case 131:
+		goto st_case_131
+	case 132:
+		goto st_case_132
+	case 133:
+		goto st_case_133
+	case 134:
+		goto st_case_134
+	case 135:
+		goto st_case_135
+	case 136:
+		goto st_case_136
+	case 137:
+		goto st_case_137
+	case 138:
+		goto st_case_138
+	case 139:
+		goto st_case_139
+	case 140:
+		goto st_case_140
+	case 141:
+		goto st_case_141
+	case 142:
+		goto st_case_142
+	case 143:
+		goto st_case_143
+	case 144:
+		goto st_case_144
+	case 145:
+		goto st_case_145
+	case 146:
+		goto st_case_146
+	case 147:
+		goto st_case_147
+	case 148:
+		goto st_case_148
+	case 149:
+		goto st_case_149
+	case 150:
+		goto st_case_150
+	case 151:
+		goto st_case_151
+	case 152:
+		goto st_case_152
+	case 153:
+		goto st_case_153
+	case 154:
+		goto st_case_154
+	case 155:
+		goto st_case_155
+	case 156:
+		goto st_case_156
+	case 157:
+		goto st_case_157
+	case 158:
+		goto st_case_158
+	case 303:
+		goto st_case_303
+	case 159:
+		goto st_case_159
+	case 160:
+		goto st_case_160
+	case 161:
+		goto st_case_161
+	case 162:
+		goto st_case_162
+	case 163:
+		goto st_case_163
+	case 164:
+		goto st_case_164
+	case 165:
+		goto st_case_165
+	case 166:
+		goto st_case_166
+	case 167:
+		goto st_case_167
+	case 168:
+		goto st_case_168
+	case 169:
+		goto st_case_169
+	case 170:
+		goto st_case_170
+	case 171:
+		goto st_case_171
+	case 172:
+		goto st_case_172
+	case 173:
+		goto st_case_173
+	case 174:
+		goto st_case_174
+	case 175:
+		goto st_case_175
+	case 176:
+		goto st_case_176
+	case 177:
+		goto st_case_177
+	case 178:
+		goto st_case_178
+	case 179:
+		goto st_case_179
+	case 180:
+		goto st_case_180
+	case 181:
+		goto st_case_181
+	case 182:
+		goto st_case_182
+	case 183:
+		goto st_case_183
+	case 184:
+		goto st_case_184
+	case 185:
+		goto st_case_185
+	case 186:
+		goto st_case_186
+	case 187:
+		goto st_case_187
+	case 188:
+		goto st_case_188
+	case 189:
+		goto st_case_189
+	case 190:
+		goto st_case_190
+	case 191:
+		goto st_case_191
+	case 192:
+		goto st_case_192
+	case 193:
+		goto st_case_193
+	case 194:
+		goto st_case_194
+	case 195:
+		goto st_case_195
+	case 196:
+		goto st_case_196
+	case 197:
+		goto st_case_197
+	case 198:
+		goto st_case_198
+	case 199:
+		goto st_case_199
+	case 200:
+		goto st_case_200
+	case 201:
+		goto st_case_201
+	case 202:
+		goto st_case_202
+	case 203:
+		goto st_case_203
+	case 204:
+		goto st_case_204
+	case 205:
+		goto st_case_205
+	case 206:
+		goto st_case_206
+	case 207:
+		goto st_case_207
+	case 208:
+		goto st_case_208
+	case 209:
+		goto st_case_209
+	case 210:
+		goto st_case_210
+	case 211:
+		goto st_case_211
+	case 212:
+		goto st_case_212
+	case 213:
+		goto st_case_213
+	case 214:
+		goto st_case_214
+	case 215:
+		goto st_case_215
+	case 216:
+		goto st_case_216
+	case 217:
+		goto st_case_217
+	case 218:
+		goto st_case_218
+	case 219:
+		goto st_case_219
+	case 220:
+		goto st_case_220
+	case 221:
+		goto st_case_221
+	case 222:
+		goto st_case_222
+	case 223:
+		goto st_case_223
+	case 224:
+		goto st_case_224
+	case 225:
+		goto st_case_225
+	case 226:
+		goto st_case_226
+	case 227:
+		goto st_case_227
+	case 228:
+		goto st_case_228
+	case 229:
+		goto st_case_229
+	case 230:
+		goto st_case_230
+	case 231:
+		goto st_case_231
+	case 232:
+		goto st_case_232
+	case 233:
+		goto st_case_233
+	case 234:
+		goto st_case_234
+	case 235:
+		goto st_case_235
+	case 236:
+		goto st_case_236
+	case 237:
+		goto st_case_237
+	case 238:
+		goto st_case_238
+	case 239:
+		goto st_case_239
+	case 240:
+		goto st_case_240
+	case 241:
+		goto st_case_241
+	case 242:
+		goto st_case_242
+	case 243:
+		goto st_case_243
+	case 244:
+		goto st_case_244
+	case 245:
+		goto st_case_245
+	case 246:
+		goto st_case_246
+	case 247:
+		goto st_case_247
+	case 248:
+		goto st_case_248
+	case 249:
+		goto st_case_249
+	case 250:
+		goto st_case_250
+	case 251:
+		goto st_case_251
+	case 252:
+		goto st_case_252
+	case 253:
+		goto st_case_253
+	case 254:
+		goto st_case_254
+	case 255:
+		goto st_case_255
+	case 256:
+		goto st_case_256
+	case 257:
+		goto st_case_257
+	case 258:
+		goto st_case_258
+	case 259:
+		goto st_case_259
+	case 260:
+		goto st_case_260
+	case 261:
+		goto st_case_261
+	case 262:
+		goto st_case_262
+	}
+	goto st_out
+	st1:
+		if p++; p == pe {
+			goto _test_eof1
+		}
+	st_case_1:
+		switch data[p] {
+		case 84:
+			goto st2
+		case 115:
+			goto st71
+		case 116:
+			goto st2
+		}
+		goto st0
+st_case_0:
+	st0:
+		cs = 0
+		goto _out
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+		switch data[p] {
+		case 69:
+			goto st3
+		case 101:
+			goto st3
+		}
+		goto st0
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+		switch data[p] {
+		case 76:
+			goto st4
+		case 108:
+			goto st4
+		}
+		goto st0
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+		if data[p] == 58 {
+			goto tr5
+		}
+		goto st0
+tr5:
+//line parser.rl:28
+ uri.scheme   = TEL 
 	goto st5
 	st5:
 		if p++; p == pe {
 			goto _test_eof5
 		}
 	st_case_5:
-//line parser_rl.go:403
+//line parser_rl.go:1343
 		switch data[p] {
-		case 33:
-			goto tr6
 		case 37:
-			goto tr7
-		case 59:
-			goto tr6
-		case 61:
-			goto tr6
-		case 63:
-			goto tr6
-		case 91:
-			goto tr10
-		case 95:
-			goto tr6
-		case 126:
 			goto tr6
+		case 42:
+			goto tr8
+		case 43:
+			goto tr9
 		}
 		switch {
 		case data[p] < 48:
-			if 36 <= data[p] && data[p] <= 47 {
-				goto tr6
+			switch {
+			case data[p] > 41:
+				if 45 <= data[p] && data[p] <= 46 {
+					goto tr7
+				}
+			case data[p] >= 40:
+				goto tr7
 			}
 		case data[p] > 57:
 			switch {
-			case data[p] > 90:
-				if 97 <= data[p] && data[p] <= 122 {
-					goto tr9
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto tr8
 				}
 			case data[p] >= 65:
-				goto tr9
+				goto tr8
 			}
 		default:
 			goto tr8
 		}
 		goto st0
 tr6:
-//line parser.rl:20
+//line parser.rl:25
  m = p 
 	goto st6
 	st6:
@@ -445,112 +1380,110 @@ tr6:
 			goto _test_eof6
 		}
 	st_case_6:
-//line parser_rl.go:449
-		switch data[p] {
-		case 33:
-			goto st6
-		case 37:
+//line parser_rl.go:1384
+		if data[p] == 50 {
 			goto st7
-		case 58:
-			goto st9
-		case 61:
-			goto st6
-		case 64:
-			goto tr14
-		case 95:
-			goto st6
-		case 126:
-			goto st6
-		}
-		switch {
-		case data[p] < 63:
-			if 36 <= data[p] && data[p] <= 59 {
-				goto st6
-			}
-		case data[p] > 90:
-			if 97 <= data[p] && data[p] <= 122 {
-				goto st6
-			}
-		default:
-			goto st6
 		}
 		goto st0
-tr7:
-//line parser.rl:20
- m = p 
-	goto st7
 	st7:
 		if p++; p == pe {
 			goto _test_eof7
 		}
 	st_case_7:
-//line parser_rl.go:488
-		switch {
-		case data[p] < 65:
-			if 48 <= data[p] && data[p] <= 57 {
-				goto st8
-			}
-		case data[p] > 70:
-			if 97 <= data[p] && data[p] <= 102 {
-				goto st8
-			}
-		default:
+		if data[p] == 51 {
 			goto st8
 		}
 		goto st0
+tr8:
+//line parser.rl:25
+ m = p 
+	goto st8
 	st8:
 		if p++; p == pe {
 			goto _test_eof8
 		}
 	st_case_8:
+//line parser_rl.go:1407
+		switch data[p] {
+		case 37:
+			goto st6
+		case 59:
+			goto tr13
+		}
 		switch {
-		case data[p] < 65:
-			if 48 <= data[p] && data[p] <= 57 {
-				goto st6
+		case data[p] < 48:
+			switch {
+			case data[p] > 42:
+				if 45 <= data[p] && data[p] <= 46 {
+					goto st8
+				}
+			case data[p] >= 40:
+				goto st8
 			}
-		case data[p] > 70:
-			if 97 <= data[p] && data[p] <= 102 {
-				goto st6
+		case data[p] > 57:
+			switch {
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st8
+				}
+			case data[p] >= 65:
+				goto st8
 			}
 		default:
-			goto st6
+			goto st8
 		}
 		goto st0
+tr13:
+//line parser.rl:29
+ uri.userinfo = str[m:p]; m = p 
+	goto st9
+tr18:
+//line parser.rl:51
+ e = p 
+//line parser.rl:52
+ uri.spans.add(e-m-1, p-m-1) 
+//line parser.rl:30
+ uri.params   = str[m+1:p] 
+	goto st9
+tr22:
+//line parser.rl:52
+ uri.spans.add(e-m-1, p-m-1) 
+//line parser.rl:30
+ uri.params   = str[m+1:p] 
+	goto st9
 	st9:
 		if p++; p == pe {
 			goto _test_eof9
 		}
 	st_case_9:
+//line parser_rl.go:1460
 		switch data[p] {
-		case 33:
-			goto st9
-		case 37:
+		case 45:
 			goto st10
-		case 61:
-			goto st9
-		case 64:
-			goto tr14
-		case 95:
-			goto st9
-		case 126:
-			goto st9
+		case 69:
+			goto st15
+		case 73:
+			goto st20
+		case 80:
+			goto st28
+		case 101:
+			goto st15
+		case 105:
+			goto st20
+		case 112:
+			goto st28
 		}
 		switch {
-		case data[p] < 48:
-			if 36 <= data[p] && data[p] <= 46 {
-				goto st9
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st10
 			}
-		case data[p] > 57:
-			switch {
-			case data[p] > 90:
-				if 97 <= data[p] && data[p] <= 122 {
-					goto st9
-				}
-			case data[p] >= 65:
-				goto st9
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st10
 			}
 		default:
-			goto st9
+			goto st10
 		}
 		goto st0
 	st10:
@@ -558,90 +1491,120 @@ tr7:
 			goto _test_eof10
 		}
 	st_case_10:
+		switch data[p] {
+		case 45:
+			goto st10
+		case 59:
+			goto tr18
+		case 61:
+			goto tr19
+		}
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st11
+				goto st10
 			}
-		case data[p] > 70:
-			if 97 <= data[p] && data[p] <= 102 {
-				goto st11
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st10
 			}
 		default:
-			goto st11
+			goto st10
 		}
 		goto st0
+tr19:
+//line parser.rl:51
+ e = p 
+	goto st11
 	st11:
 		if p++; p == pe {
 			goto _test_eof11
 		}
 	st_case_11:
+//line parser_rl.go:1525
+		switch data[p] {
+		case 33:
+			goto st12
+		case 37:
+			goto st13
+		case 93:
+			goto st12
+		case 95:
+			goto st12
+		case 126:
+			goto st12
+		}
 		switch {
-		case data[p] < 65:
-			if 48 <= data[p] && data[p] <= 57 {
-				goto st9
+		case data[p] < 45:
+			if 36 <= data[p] && data[p] <= 43 {
+				goto st12
 			}
-		case data[p] > 70:
-			if 97 <= data[p] && data[p] <= 102 {
-				goto st9
+		case data[p] > 58:
+			switch {
+			case data[p] > 91:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st12
+				}
+			case data[p] >= 65:
+				goto st12
 			}
 		default:
-			goto st9
+			goto st12
 		}
 		goto st0
-tr14:
-//line parser.rl:23
- uri.userinfo = str[m:p] 
-	goto st12
 	st12:
 		if p++; p == pe {
 			goto _test_eof12
 		}
 	st_case_12:
-//line parser_rl.go:602
-		if data[p] == 91 {
-			goto tr10
+		switch data[p] {
+		case 33:
+			goto st12
+		case 37:
+			goto st13
+		case 59:
+			goto tr22
+		case 93:
+			goto st12
+		case 95:
+			goto st12
+		case 126:
+			goto st12
 		}
 		switch {
-		case data[p] < 65:
-			if 48 <= data[p] && data[p] <= 57 {
-				goto tr18
+		case data[p] < 45:
+			if 36 <= data[p] && data[p] <= 43 {
+				goto st12
 			}
-		case data[p] > 90:
-			if 97 <= data[p] && data[p] <= 122 {
-				goto tr19
+		case data[p] > 58:
+			switch {
+			case data[p] > 91:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st12
+				}
+			case data[p] >= 65:
+				goto st12
 			}
 		default:
-			goto tr19
+			goto st12
 		}
 		goto st0
-tr18:
-//line parser.rl:20
- m = p 
-	goto st13
 	st13:
 		if p++; p == pe {
 			goto _test_eof13
 		}
 	st_case_13:
-//line parser_rl.go:628
-		switch data[p] {
-		case 45:
-			goto st14
-		case 46:
-			goto st32
-		}
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st41
+				goto st14
 			}
-		case data[p] > 90:
-			if 97 <= data[p] && data[p] <= 122 {
-				goto st15
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st14
 			}
 		default:
-			goto st15
+			goto st14
 		}
 		goto st0
 	st14:
@@ -649,20 +1612,17 @@ tr18:
 			goto _test_eof14
 		}
 	st_case_14:
-		if data[p] == 45 {
-			goto st14
-		}
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st15
+				goto st12
 			}
-		case data[p] > 90:
-			if 97 <= data[p] && data[p] <= 122 {
-				goto st15
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st12
 			}
 		default:
-			goto st15
+			goto st12
 		}
 		goto st0
 	st15:
@@ -672,21 +1632,27 @@ tr18:
 	st_case_15:
 		switch data[p] {
 		case 45:
-			goto st14
-		case 46:
+			goto st10
+		case 59:
+			goto tr18
+		case 61:
+			goto tr19
+		case 88:
+			goto st16
+		case 120:
 			goto st16
 		}
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st15
+				goto st10
 			}
 		case data[p] > 90:
 			if 97 <= data[p] && data[p] <= 122 {
-				goto st15
+				goto st10
 			}
 		default:
-			goto st15
+			goto st10
 		}
 		goto st0
 	st16:
@@ -694,52 +1660,29 @@ tr18:
 			goto _test_eof16
 		}
 	st_case_16:
-		switch {
-		case data[p] < 65:
-			if 48 <= data[p] && data[p] <= 57 {
-				goto st15
-			}
-		case data[p] > 90:
-			if 97 <= data[p] && data[p] <= 122 {
-				goto st120
-			}
-		default:
-			goto st120
-		}
-		goto st0
-tr19:
-//line parser.rl:20
- m = p 
-	goto st120
-	st120:
-		if p++; p == pe {
-			goto _test_eof120
-		}
-	st_case_120:
-//line parser_rl.go:720
 		switch data[p] {
 		case 45:
-			goto st17
-		case 46:
-			goto st121
-		case 58:
-			goto st18
+			goto st10
 		case 59:
-			goto tr131
-		case 63:
-			goto tr132
+			goto tr18
+		case 61:
+			goto tr19
+		case 84:
+			goto st17
+		case 116:
+			goto st17
 		}
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st120
+				goto st10
 			}
 		case data[p] > 90:
 			if 97 <= data[p] && data[p] <= 122 {
-				goto st120
+				goto st10
 			}
 		default:
-			goto st120
+			goto st10
 		}
 		goto st0
 	st17:
@@ -747,1055 +1690,1110 @@ tr19:
 			goto _test_eof17
 		}
 	st_case_17:
-		if data[p] == 45 {
-			goto st17
-		}
-		switch {
-		case data[p] < 65:
-			if 48 <= data[p] && data[p] <= 57 {
-				goto st120
-			}
-		case data[p] > 90:
-			if 97 <= data[p] && data[p] <= 122 {
-				goto st120
-			}
-		default:
-			goto st120
-		}
-		goto st0
-	st121:
-		if p++; p == pe {
-			goto _test_eof121
-		}
-	st_case_121:
 		switch data[p] {
-		case 58:
-			goto st18
-		case 59:
-			goto tr131
-		case 63:
-			goto tr132
+		case 45:
+			goto st10
+		case 61:
+			goto tr26
 		}
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st15
+				goto st10
 			}
 		case data[p] > 90:
 			if 97 <= data[p] && data[p] <= 122 {
-				goto st120
+				goto st10
 			}
 		default:
-			goto st120
+			goto st10
 		}
 		goto st0
+tr26:
+//line parser.rl:51
+ e = p 
+//line parser.rl:33
+ if ext { {cs = (uri_error); goto _again } }; ext = true 
+	goto st18
 	st18:
 		if p++; p == pe {
 			goto _test_eof18
 		}
 	st_case_18:
-		if 48 <= data[p] && data[p] <= 57 {
-			goto st122
+//line parser_rl.go:1724
+		switch {
+		case data[p] < 45:
+			if 40 <= data[p] && data[p] <= 41 {
+				goto st18
+			}
+		case data[p] > 46:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st19
+			}
+		default:
+			goto st18
 		}
 		goto st0
-	st122:
+	st19:
 		if p++; p == pe {
-			goto _test_eof122
+			goto _test_eof19
 		}
-	st_case_122:
-		switch data[p] {
-		case 59:
-			goto tr131
-		case 63:
-			goto tr132
+	st_case_19:
+		if data[p] == 59 {
+			goto tr22
 		}
-		if 48 <= data[p] && data[p] <= 57 {
-			goto st123
+		switch {
+		case data[p] < 45:
+			if 40 <= data[p] && data[p] <= 41 {
+				goto st19
+			}
+		case data[p] > 46:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st19
+			}
+		default:
+			goto st19
 		}
 		goto st0
-	st123:
+	st20:
 		if p++; p == pe {
-			goto _test_eof123
+			goto _test_eof20
 		}
-	st_case_123:
+	st_case_20:
 		switch data[p] {
+		case 45:
+			goto st10
 		case 59:
-			goto tr131
-		case 63:
-			goto tr132
+			goto tr18
+		case 61:
+			goto tr19
+		case 83:
+			goto st21
+		case 115:
+			goto st21
 		}
-		if 48 <= data[p] && data[p] <= 57 {
-			goto st124
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st10
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st10
+			}
+		default:
+			goto st10
 		}
 		goto st0
-	st124:
+	st21:
 		if p++; p == pe {
-			goto _test_eof124
+			goto _test_eof21
 		}
-	st_case_124:
+	st_case_21:
 		switch data[p] {
+		case 45:
+			goto st10
 		case 59:
-			goto tr131
-		case 63:
-			goto tr132
+			goto tr18
+		case 61:
+			goto tr19
+		case 85:
+			goto st22
+		case 117:
+			goto st22
 		}
-		if 48 <= data[p] && data[p] <= 57 {
-			goto st125
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st10
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st10
+			}
+		default:
+			goto st10
 		}
 		goto st0
-	st125:
+	st22:
 		if p++; p == pe {
-			goto _test_eof125
+			goto _test_eof22
 		}
-	st_case_125:
+	st_case_22:
 		switch data[p] {
+		case 45:
+			goto st10
 		case 59:
-			goto tr131
-		case 63:
-			goto tr132
+			goto tr18
+		case 61:
+			goto tr19
+		case 66:
+			goto st23
+		case 98:
+			goto st23
 		}
-		if 48 <= data[p] && data[p] <= 57 {
-			goto st126
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st10
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st10
+			}
+		default:
+			goto st10
 		}
 		goto st0
-	st126:
+	st23:
 		if p++; p == pe {
-			goto _test_eof126
+			goto _test_eof23
 		}
-	st_case_126:
+	st_case_23:
 		switch data[p] {
-		case 59:
-			goto tr131
-		case 63:
-			goto tr132
+		case 45:
+			goto st10
+		case 61:
+			goto tr32
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st10
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st10
+			}
+		default:
+			goto st10
 		}
 		goto st0
-tr131:
-//line parser.rl:24
- uri.hostport = str[m:p] 
-//line parser.rl:20
- m = p 
-	goto st19
-	st19:
+tr32:
+//line parser.rl:51
+ e = p 
+//line parser.rl:32
+ if isub { {cs = (uri_error); goto _again } }; isub = true 
+	goto st24
+	st24:
 		if p++; p == pe {
-			goto _test_eof19
+			goto _test_eof24
 		}
-	st_case_19:
-//line parser_rl.go:885
+	st_case_24:
+//line parser_rl.go:1884
 		switch data[p] {
 		case 33:
-			goto st127
+			goto st25
 		case 37:
-			goto st20
-		case 93:
-			goto st127
+			goto st26
+		case 61:
+			goto st25
 		case 95:
-			goto st127
+			goto st25
 		case 126:
-			goto st127
+			goto st25
 		}
 		switch {
-		case data[p] < 45:
-			if 36 <= data[p] && data[p] <= 43 {
-				goto st127
+		case data[p] < 63:
+			if 36 <= data[p] && data[p] <= 58 {
+				goto st25
 			}
-		case data[p] > 58:
-			switch {
-			case data[p] > 91:
-				if 97 <= data[p] && data[p] <= 122 {
-					goto st127
-				}
-			case data[p] >= 65:
-				goto st127
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st25
 			}
 		default:
-			goto st127
+			goto st25
 		}
 		goto st0
-	st127:
+	st25:
 		if p++; p == pe {
-			goto _test_eof127
+			goto _test_eof25
 		}
-	st_case_127:
+	st_case_25:
 		switch data[p] {
 		case 33:
-			goto st127
+			goto st25
 		case 37:
-			goto st20
+			goto st26
 		case 59:
-			goto st19
+			goto tr22
 		case 61:
-			goto st22
-		case 63:
-			goto tr139
-		case 93:
-			goto st127
+			goto st25
 		case 95:
-			goto st127
+			goto st25
 		case 126:
-			goto st127
+			goto st25
 		}
 		switch {
-		case data[p] < 45:
-			if 36 <= data[p] && data[p] <= 43 {
-				goto st127
+		case data[p] < 63:
+			if 36 <= data[p] && data[p] <= 58 {
+				goto st25
 			}
-		case data[p] > 58:
-			switch {
-			case data[p] > 91:
-				if 97 <= data[p] && data[p] <= 122 {
-					goto st127
-				}
-			case data[p] >= 65:
-				goto st127
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st25
 			}
 		default:
-			goto st127
+			goto st25
 		}
 		goto st0
-	st20:
+	st26:
 		if p++; p == pe {
-			goto _test_eof20
+			goto _test_eof26
 		}
-	st_case_20:
+	st_case_26:
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st21
+				goto st27
 			}
 		case data[p] > 70:
 			if 97 <= data[p] && data[p] <= 102 {
-				goto st21
+				goto st27
 			}
 		default:
-			goto st21
+			goto st27
 		}
 		goto st0
-	st21:
+	st27:
 		if p++; p == pe {
-			goto _test_eof21
+			goto _test_eof27
 		}
-	st_case_21:
+	st_case_27:
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st127
+				goto st25
 			}
 		case data[p] > 70:
 			if 97 <= data[p] && data[p] <= 102 {
-				goto st127
+				goto st25
 			}
 		default:
-			goto st127
+			goto st25
 		}
 		goto st0
-	st22:
+	st28:
 		if p++; p == pe {
-			goto _test_eof22
+			goto _test_eof28
 		}
-	st_case_22:
+	st_case_28:
 		switch data[p] {
-		case 33:
-			goto st128
-		case 37:
-			goto st23
-		case 93:
-			goto st128
-		case 95:
-			goto st128
-		case 126:
-			goto st128
+		case 45:
+			goto st10
+		case 59:
+			goto tr18
+		case 61:
+			goto tr19
+		case 72:
+			goto st29
+		case 79:
+			goto st61
+		case 104:
+			goto st29
+		case 111:
+			goto st61
 		}
 		switch {
-		case data[p] < 45:
-			if 36 <= data[p] && data[p] <= 43 {
-				goto st128
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st10
 			}
-		case data[p] > 58:
-			switch {
-			case data[p] > 91:
-				if 97 <= data[p] && data[p] <= 122 {
-					goto st128
-				}
-			case data[p] >= 65:
-				goto st128
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st10
 			}
 		default:
-			goto st128
+			goto st10
 		}
 		goto st0
-	st128:
+	st29:
 		if p++; p == pe {
-			goto _test_eof128
+			goto _test_eof29
 		}
-	st_case_128:
+	st_case_29:
 		switch data[p] {
-		case 33:
-			goto st128
-		case 37:
-			goto st23
+		case 45:
+			goto st10
 		case 59:
-			goto st19
-		case 63:
-			goto tr139
-		case 93:
-			goto st128
-		case 95:
-			goto st128
-		case 126:
-			goto st128
+			goto tr18
+		case 61:
+			goto tr19
+		case 79:
+			goto st30
+		case 111:
+			goto st30
 		}
 		switch {
-		case data[p] < 45:
-			if 36 <= data[p] && data[p] <= 43 {
-				goto st128
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st10
 			}
-		case data[p] > 58:
-			switch {
-			case data[p] > 91:
-				if 97 <= data[p] && data[p] <= 122 {
-					goto st128
-				}
-			case data[p] >= 65:
-				goto st128
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st10
 			}
 		default:
-			goto st128
+			goto st10
 		}
 		goto st0
-	st23:
+	st30:
 		if p++; p == pe {
-			goto _test_eof23
+			goto _test_eof30
+		}
+	st_case_30:
+		switch data[p] {
+		case 45:
+			goto st10
+		case 59:
+			goto tr18
+		case 61:
+			goto tr19
+		case 78:
+			goto st31
+		case 110:
+			goto st31
 		}
-	st_case_23:
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st24
+				goto st10
 			}
-		case data[p] > 70:
-			if 97 <= data[p] && data[p] <= 102 {
-				goto st24
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st10
 			}
 		default:
-			goto st24
+			goto st10
 		}
 		goto st0
-	st24:
+	st31:
 		if p++; p == pe {
-			goto _test_eof24
+			goto _test_eof31
+		}
+	st_case_31:
+		switch data[p] {
+		case 45:
+			goto st10
+		case 59:
+			goto tr18
+		case 61:
+			goto tr19
+		case 69:
+			goto st32
+		case 101:
+			goto st32
 		}
-	st_case_24:
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st128
+				goto st10
 			}
-		case data[p] > 70:
-			if 97 <= data[p] && data[p] <= 102 {
-				goto st128
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st10
 			}
 		default:
-			goto st128
+			goto st10
 		}
 		goto st0
-tr132:
-//line parser.rl:24
- uri.hostport = str[m:p] 
-//line parser.rl:20
- m = p 
-//line parser.rl:25
- uri.params   = str[m:p] 
-	goto st25
-tr139:
-//line parser.rl:25
- uri.params   = str[m:p] 
-	goto st25
-	st25:
+	st32:
 		if p++; p == pe {
-			goto _test_eof25
+			goto _test_eof32
 		}
-	st_case_25:
-//line parser_rl.go:1120
+	st_case_32:
 		switch data[p] {
-		case 33:
-			goto tr34
-		case 36:
-			goto tr34
-		case 37:
-			goto tr35
-		case 63:
-			goto tr34
-		case 93:
-			goto tr34
-		case 95:
-			goto tr34
-		case 126:
-			goto tr34
+		case 45:
+			goto st33
+		case 59:
+			goto tr18
+		case 61:
+			goto tr19
 		}
 		switch {
-		case data[p] < 45:
-			if 39 <= data[p] && data[p] <= 43 {
-				goto tr34
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st10
 			}
-		case data[p] > 58:
-			switch {
-			case data[p] > 91:
-				if 97 <= data[p] && data[p] <= 122 {
-					goto tr34
-				}
-			case data[p] >= 65:
-				goto tr34
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st10
 			}
 		default:
-			goto tr34
+			goto st10
 		}
 		goto st0
-tr34:
-//line parser.rl:20
- m = p 
-	goto st26
-	st26:
+	st33:
 		if p++; p == pe {
-			goto _test_eof26
+			goto _test_eof33
 		}
-	st_case_26:
-//line parser_rl.go:1164
+	st_case_33:
 		switch data[p] {
-		case 33:
-			goto st26
-		case 36:
-			goto st26
-		case 37:
-			goto st27
+		case 45:
+			goto st10
+		case 59:
+			goto tr18
 		case 61:
-			goto st129
-		case 63:
-			goto st26
-		case 93:
-			goto st26
-		case 95:
-			goto st26
-		case 126:
-			goto st26
+			goto tr19
+		case 67:
+			goto st34
+		case 99:
+			goto st34
 		}
 		switch {
-		case data[p] < 45:
-			if 39 <= data[p] && data[p] <= 43 {
-				goto st26
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st10
 			}
-		case data[p] > 58:
-			switch {
-			case data[p] > 91:
-				if 97 <= data[p] && data[p] <= 122 {
-					goto st26
-				}
-			case data[p] >= 65:
-				goto st26
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st10
 			}
 		default:
-			goto st26
+			goto st10
 		}
 		goto st0
-tr35:
-//line parser.rl:20
- m = p 
-	goto st27
-	st27:
+	st34:
 		if p++; p == pe {
-			goto _test_eof27
+			goto _test_eof34
+		}
+	st_case_34:
+		switch data[p] {
+		case 45:
+			goto st10
+		case 59:
+			goto tr18
+		case 61:
+			goto tr19
+		case 79:
+			goto st35
+		case 111:
+			goto st35
 		}
-	st_case_27:
-//line parser_rl.go:1210
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st28
-			}
-		case data[p] > 70:
-			if 97 <= data[p] && data[p] <= 102 {
-				goto st28
+				goto st10
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st10
 			}
 		default:
-			goto st28
+			goto st10
 		}
 		goto st0
-	st28:
+	st35:
 		if p++; p == pe {
-			goto _test_eof28
+			goto _test_eof35
+		}
+	st_case_35:
+		switch data[p] {
+		case 45:
+			goto st10
+		case 59:
+			goto tr18
+		case 61:
+			goto tr19
+		case 78:
+			goto st36
+		case 110:
+			goto st36
 		}
-	st_case_28:
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st26
+				goto st10
 			}
-		case data[p] > 70:
-			if 97 <= data[p] && data[p] <= 102 {
-				goto st26
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st10
 			}
 		default:
-			goto st26
+			goto st10
 		}
 		goto st0
-	st129:
+	st36:
 		if p++; p == pe {
-			goto _test_eof129
+			goto _test_eof36
 		}
-	st_case_129:
+	st_case_36:
 		switch data[p] {
-		case 33:
-			goto st129
-		case 37:
-			goto st29
-		case 38:
-			goto st31
-		case 63:
-			goto st129
-		case 93:
-			goto st129
-		case 95:
-			goto st129
-		case 126:
-			goto st129
-		}
-		switch {
-		case data[p] < 45:
-			if 36 <= data[p] && data[p] <= 43 {
-				goto st129
-			}
-		case data[p] > 58:
-			switch {
-			case data[p] > 91:
-				if 97 <= data[p] && data[p] <= 122 {
-					goto st129
-				}
-			case data[p] >= 65:
-				goto st129
-			}
-		default:
-			goto st129
-		}
-		goto st0
-	st29:
-		if p++; p == pe {
-			goto _test_eof29
+		case 45:
+			goto st10
+		case 59:
+			goto tr18
+		case 61:
+			goto tr19
+		case 84:
+			goto st37
+		case 116:
+			goto st37
 		}
-	st_case_29:
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st30
+				goto st10
 			}
-		case data[p] > 70:
-			if 97 <= data[p] && data[p] <= 102 {
-				goto st30
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st10
 			}
 		default:
-			goto st30
+			goto st10
 		}
 		goto st0
-	st30:
+	st37:
 		if p++; p == pe {
-			goto _test_eof30
+			goto _test_eof37
+		}
+	st_case_37:
+		switch data[p] {
+		case 45:
+			goto st10
+		case 59:
+			goto tr18
+		case 61:
+			goto tr19
+		case 69:
+			goto st38
+		case 101:
+			goto st38
 		}
-	st_case_30:
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st129
+				goto st10
 			}
-		case data[p] > 70:
-			if 97 <= data[p] && data[p] <= 102 {
-				goto st129
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st10
 			}
 		default:
-			goto st129
+			goto st10
 		}
 		goto st0
-	st31:
+	st38:
 		if p++; p == pe {
-			goto _test_eof31
+			goto _test_eof38
 		}
-	st_case_31:
+	st_case_38:
 		switch data[p] {
-		case 33:
-			goto st26
-		case 36:
-			goto st26
-		case 37:
-			goto st27
-		case 63:
-			goto st26
-		case 93:
-			goto st26
-		case 95:
-			goto st26
-		case 126:
-			goto st26
-		}
-		switch {
-		case data[p] < 45:
-			if 39 <= data[p] && data[p] <= 43 {
-				goto st26
-			}
-		case data[p] > 58:
-			switch {
-			case data[p] > 91:
-				if 97 <= data[p] && data[p] <= 122 {
-					goto st26
-				}
-			case data[p] >= 65:
-				goto st26
-			}
-		default:
-			goto st26
-		}
-		goto st0
-	st32:
-		if p++; p == pe {
-			goto _test_eof32
+		case 45:
+			goto st10
+		case 59:
+			goto tr18
+		case 61:
+			goto tr19
+		case 88:
+			goto st39
+		case 120:
+			goto st39
 		}
-	st_case_32:
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st33
+				goto st10
 			}
 		case data[p] > 90:
 			if 97 <= data[p] && data[p] <= 122 {
-				goto st120
+				goto st10
 			}
 		default:
-			goto st120
+			goto st10
 		}
 		goto st0
-	st33:
+	st39:
 		if p++; p == pe {
-			goto _test_eof33
+			goto _test_eof39
 		}
-	st_case_33:
+	st_case_39:
 		switch data[p] {
 		case 45:
-			goto st14
-		case 46:
-			goto st34
+			goto st10
+		case 59:
+			goto tr18
+		case 61:
+			goto tr19
+		case 84:
+			goto st40
+		case 116:
+			goto st40
 		}
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st39
+				goto st10
 			}
 		case data[p] > 90:
 			if 97 <= data[p] && data[p] <= 122 {
-				goto st15
+				goto st10
 			}
 		default:
-			goto st15
+			goto st10
 		}
 		goto st0
-	st34:
+	st40:
 		if p++; p == pe {
-			goto _test_eof34
+			goto _test_eof40
+		}
+	st_case_40:
+		switch data[p] {
+		case 45:
+			goto st10
+		case 61:
+			goto tr49
 		}
-	st_case_34:
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st35
+				goto st10
 			}
 		case data[p] > 90:
 			if 97 <= data[p] && data[p] <= 122 {
-				goto st120
+				goto st10
 			}
 		default:
-			goto st120
+			goto st10
 		}
 		goto st0
-	st35:
+tr49:
+//line parser.rl:51
+ e = p 
+	goto st41
+	st41:
 		if p++; p == pe {
-			goto _test_eof35
+			goto _test_eof41
 		}
-	st_case_35:
-		switch data[p] {
-		case 45:
-			goto st14
-		case 46:
-			goto st36
+	st_case_41:
+//line parser_rl.go:2371
+		if data[p] == 43 {
+			goto st42
 		}
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st37
+				goto st57
 			}
 		case data[p] > 90:
 			if 97 <= data[p] && data[p] <= 122 {
-				goto st15
+				goto st288
 			}
 		default:
-			goto st15
+			goto st288
 		}
 		goto st0
-	st36:
+tr61:
+//line parser.rl:51
+ e = p 
+//line parser.rl:33
+ if ext { {cs = (uri_error); goto _again } }; ext = true 
+	goto st42
+	st42:
 		if p++; p == pe {
-			goto _test_eof36
+			goto _test_eof42
 		}
-	st_case_36:
+	st_case_42:
+//line parser_rl.go:2399
 		switch {
-		case data[p] < 65:
-			if 48 <= data[p] && data[p] <= 57 {
-				goto st130
+		case data[p] < 45:
+			if 40 <= data[p] && data[p] <= 41 {
+				goto st42
 			}
-		case data[p] > 90:
-			if 97 <= data[p] && data[p] <= 122 {
-				goto st120
+		case data[p] > 46:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st263
 			}
 		default:
-			goto st120
+			goto st42
 		}
 		goto st0
-	st130:
+	st263:
 		if p++; p == pe {
-			goto _test_eof130
+			goto _test_eof263
 		}
-	st_case_130:
-		switch data[p] {
-		case 45:
-			goto st14
-		case 46:
-			goto st16
-		case 58:
-			goto st18
-		case 59:
-			goto tr131
-		case 63:
-			goto tr132
+	st_case_263:
+		if data[p] == 59 {
+			goto tr284
 		}
 		switch {
-		case data[p] < 65:
-			if 48 <= data[p] && data[p] <= 57 {
-				goto st131
+		case data[p] < 45:
+			if 40 <= data[p] && data[p] <= 41 {
+				goto st263
 			}
-		case data[p] > 90:
-			if 97 <= data[p] && data[p] <= 122 {
-				goto st15
+		case data[p] > 46:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st263
 			}
 		default:
-			goto st15
+			goto st263
 		}
 		goto st0
-	st131:
+tr309:
+//line parser.rl:29
+ uri.userinfo = str[m:p]; m = p 
+	goto st43
+tr285:
+//line parser.rl:51
+ e = p 
+//line parser.rl:52
+ uri.spans.add(e-m-1, p-m-1) 
+//line parser.rl:30
+ uri.params   = str[m+1:p] 
+	goto st43
+tr284:
+//line parser.rl:52
+ uri.spans.add(e-m-1, p-m-1) 
+//line parser.rl:30
+ uri.params   = str[m+1:p] 
+	goto st43
+	st43:
 		if p++; p == pe {
-			goto _test_eof131
+			goto _test_eof43
 		}
-	st_case_131:
+	st_case_43:
+//line parser_rl.go:2457
 		switch data[p] {
 		case 45:
-			goto st14
-		case 46:
-			goto st16
-		case 58:
-			goto st18
-		case 59:
-			goto tr131
-		case 63:
-			goto tr132
+			goto st264
+		case 69:
+			goto st266
+		case 73:
+			goto st268
+		case 80:
+			goto st272
+		case 101:
+			goto st266
+		case 105:
+			goto st268
+		case 112:
+			goto st272
 		}
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st132
+				goto st264
 			}
 		case data[p] > 90:
 			if 97 <= data[p] && data[p] <= 122 {
-				goto st15
+				goto st264
 			}
 		default:
-			goto st15
+			goto st264
 		}
 		goto st0
-	st132:
+	st264:
 		if p++; p == pe {
-			goto _test_eof132
+			goto _test_eof264
 		}
-	st_case_132:
+	st_case_264:
 		switch data[p] {
 		case 45:
-			goto st14
-		case 46:
-			goto st16
-		case 58:
-			goto st18
+			goto st264
 		case 59:
-			goto tr131
-		case 63:
-			goto tr132
+			goto tr285
+		case 61:
+			goto tr286
 		}
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st15
+				goto st264
 			}
 		case data[p] > 90:
 			if 97 <= data[p] && data[p] <= 122 {
-				goto st15
+				goto st264
 			}
 		default:
-			goto st15
+			goto st264
 		}
 		goto st0
-	st37:
+tr286:
+//line parser.rl:51
+ e = p 
+	goto st44
+	st44:
 		if p++; p == pe {
-			goto _test_eof37
+			goto _test_eof44
 		}
-	st_case_37:
+	st_case_44:
+//line parser_rl.go:2522
 		switch data[p] {
-		case 45:
-			goto st14
-		case 46:
-			goto st36
+		case 33:
+			goto st265
+		case 37:
+			goto st45
+		case 93:
+			goto st265
+		case 95:
+			goto st265
+		case 126:
+			goto st265
 		}
 		switch {
-		case data[p] < 65:
-			if 48 <= data[p] && data[p] <= 57 {
-				goto st38
+		case data[p] < 45:
+			if 36 <= data[p] && data[p] <= 43 {
+				goto st265
 			}
-		case data[p] > 90:
-			if 97 <= data[p] && data[p] <= 122 {
-				goto st15
+		case data[p] > 58:
+			switch {
+			case data[p] > 91:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st265
+				}
+			case data[p] >= 65:
+				goto st265
 			}
 		default:
-			goto st15
+			goto st265
 		}
 		goto st0
-	st38:
+	st265:
 		if p++; p == pe {
-			goto _test_eof38
+			goto _test_eof265
 		}
-	st_case_38:
+	st_case_265:
 		switch data[p] {
-		case 45:
-			goto st14
-		case 46:
-			goto st36
+		case 33:
+			goto st265
+		case 37:
+			goto st45
+		case 59:
+			goto tr284
+		case 93:
+			goto st265
+		case 95:
+			goto st265
+		case 126:
+			goto st265
 		}
 		switch {
-		case data[p] < 65:
-			if 48 <= data[p] && data[p] <= 57 {
-				goto st15
+		case data[p] < 45:
+			if 36 <= data[p] && data[p] <= 43 {
+				goto st265
 			}
-		case data[p] > 90:
-			if 97 <= data[p] && data[p] <= 122 {
-				goto st15
+		case data[p] > 58:
+			switch {
+			case data[p] > 91:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st265
+				}
+			case data[p] >= 65:
+				goto st265
 			}
 		default:
-			goto st15
+			goto st265
 		}
 		goto st0
-	st39:
+	st45:
 		if p++; p == pe {
-			goto _test_eof39
-		}
-	st_case_39:
-		switch data[p] {
-		case 45:
-			goto st14
-		case 46:
-			goto st34
+			goto _test_eof45
 		}
+	st_case_45:
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st40
+				goto st46
 			}
-		case data[p] > 90:
-			if 97 <= data[p] && data[p] <= 122 {
-				goto st15
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st46
 			}
 		default:
-			goto st15
+			goto st46
 		}
 		goto st0
-	st40:
+	st46:
 		if p++; p == pe {
-			goto _test_eof40
-		}
-	st_case_40:
-		switch data[p] {
-		case 45:
-			goto st14
-		case 46:
-			goto st34
+			goto _test_eof46
 		}
+	st_case_46:
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st15
+				goto st265
 			}
-		case data[p] > 90:
-			if 97 <= data[p] && data[p] <= 122 {
-				goto st15
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st265
 			}
 		default:
-			goto st15
+			goto st265
 		}
 		goto st0
-	st41:
+	st266:
 		if p++; p == pe {
-			goto _test_eof41
+			goto _test_eof266
 		}
-	st_case_41:
+	st_case_266:
 		switch data[p] {
 		case 45:
-			goto st14
-		case 46:
-			goto st32
+			goto st264
+		case 59:
+			goto tr285
+		case 61:
+			goto tr286
+		case 88:
+			goto st267
+		case 120:
+			goto st267
 		}
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st42
+				goto st264
 			}
 		case data[p] > 90:
 			if 97 <= data[p] && data[p] <= 122 {
-				goto st15
+				goto st264
 			}
 		default:
-			goto st15
+			goto st264
 		}
 		goto st0
-	st42:
+	st267:
 		if p++; p == pe {
-			goto _test_eof42
+			goto _test_eof267
 		}
-	st_case_42:
+	st_case_267:
 		switch data[p] {
 		case 45:
-			goto st14
-		case 46:
-			goto st32
+			goto st264
+		case 59:
+			goto tr285
+		case 61:
+			goto tr286
+		case 84:
+			goto st47
+		case 116:
+			goto st47
 		}
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st15
+				goto st264
 			}
 		case data[p] > 90:
 			if 97 <= data[p] && data[p] <= 122 {
-				goto st15
+				goto st264
 			}
 		default:
-			goto st15
+			goto st264
 		}
 		goto st0
-tr10:
-//line parser.rl:20
- m = p 
-	goto st43
-	st43:
+	st47:
 		if p++; p == pe {
-			goto _test_eof43
+			goto _test_eof47
 		}
-	st_case_43:
-//line parser_rl.go:1701
-		if data[p] == 58 {
-			goto st77
+	st_case_47:
+		switch data[p] {
+		case 45:
+			goto st264
+		case 61:
+			goto tr61
 		}
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st44
+				goto st264
 			}
-		case data[p] > 70:
-			if 97 <= data[p] && data[p] <= 102 {
-				goto st44
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st264
 			}
 		default:
-			goto st44
+			goto st264
 		}
 		goto st0
-	st44:
+	st268:
 		if p++; p == pe {
-			goto _test_eof44
+			goto _test_eof268
 		}
-	st_case_44:
+	st_case_268:
 		switch data[p] {
-		case 58:
-			goto st48
-		case 93:
-			goto st133
+		case 45:
+			goto st264
+		case 59:
+			goto tr285
+		case 61:
+			goto tr286
+		case 83:
+			goto st269
+		case 115:
+			goto st269
 		}
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st45
+				goto st264
 			}
-		case data[p] > 70:
-			if 97 <= data[p] && data[p] <= 102 {
-				goto st45
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st264
 			}
 		default:
-			goto st45
+			goto st264
 		}
 		goto st0
-	st45:
+	st269:
 		if p++; p == pe {
-			goto _test_eof45
+			goto _test_eof269
 		}
-	st_case_45:
+	st_case_269:
 		switch data[p] {
-		case 58:
-			goto st48
-		case 93:
-			goto st133
+		case 45:
+			goto st264
+		case 59:
+			goto tr285
+		case 61:
+			goto tr286
+		case 85:
+			goto st270
+		case 117:
+			goto st270
 		}
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st46
+				goto st264
 			}
-		case data[p] > 70:
-			if 97 <= data[p] && data[p] <= 102 {
-				goto st46
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st264
 			}
 		default:
-			goto st46
+			goto st264
 		}
 		goto st0
-	st46:
+	st270:
 		if p++; p == pe {
-			goto _test_eof46
+			goto _test_eof270
 		}
-	st_case_46:
+	st_case_270:
 		switch data[p] {
-		case 58:
+		case 45:
+			goto st264
+		case 59:
+			goto tr285
+		case 61:
+			goto tr286
+		case 66:
+			goto st48
+		case 98:
 			goto st48
-		case 93:
-			goto st133
 		}
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st47
+				goto st264
 			}
-		case data[p] > 70:
-			if 97 <= data[p] && data[p] <= 102 {
-				goto st47
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st264
 			}
 		default:
-			goto st47
-		}
-		goto st0
-	st47:
-		if p++; p == pe {
-			goto _test_eof47
-		}
-	st_case_47:
-		switch data[p] {
-		case 58:
-			goto st48
-		case 93:
-			goto st133
+			goto st264
 		}
 		goto st0
 	st48:
@@ -1803,46 +2801,92 @@ tr10:
 			goto _test_eof48
 		}
 	st_case_48:
-		if data[p] == 58 {
-			goto st64
+		switch data[p] {
+		case 45:
+			goto st264
+		case 61:
+			goto tr62
 		}
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st49
+				goto st264
 			}
-		case data[p] > 70:
-			if 97 <= data[p] && data[p] <= 102 {
-				goto st44
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st264
 			}
 		default:
-			goto st44
+			goto st264
 		}
 		goto st0
+tr62:
+//line parser.rl:51
+ e = p 
+//line parser.rl:32
+ if isub { {cs = (uri_error); goto _again } }; isub = true 
+	goto st49
 	st49:
 		if p++; p == pe {
 			goto _test_eof49
 		}
 	st_case_49:
+//line parser_rl.go:2835
 		switch data[p] {
-		case 46:
+		case 33:
+			goto st271
+		case 37:
 			goto st50
-		case 58:
-			goto st48
-		case 93:
-			goto st133
+		case 61:
+			goto st271
+		case 95:
+			goto st271
+		case 126:
+			goto st271
 		}
 		switch {
-		case data[p] < 65:
-			if 48 <= data[p] && data[p] <= 57 {
-				goto st62
+		case data[p] < 63:
+			if 36 <= data[p] && data[p] <= 58 {
+				goto st271
 			}
-		case data[p] > 70:
-			if 97 <= data[p] && data[p] <= 102 {
-				goto st45
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st271
 			}
 		default:
-			goto st45
+			goto st271
+		}
+		goto st0
+	st271:
+		if p++; p == pe {
+			goto _test_eof271
+		}
+	st_case_271:
+		switch data[p] {
+		case 33:
+			goto st271
+		case 37:
+			goto st50
+		case 59:
+			goto tr284
+		case 61:
+			goto st271
+		case 95:
+			goto st271
+		case 126:
+			goto st271
+		}
+		switch {
+		case data[p] < 63:
+			if 36 <= data[p] && data[p] <= 58 {
+				goto st271
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st271
+			}
+		default:
+			goto st271
 		}
 		goto st0
 	st50:
@@ -1850,7 +2894,16 @@ tr10:
 			goto _test_eof50
 		}
 	st_case_50:
-		if 48 <= data[p] && data[p] <= 57 {
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st51
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st51
+			}
+		default:
 			goto st51
 		}
 		goto st0
@@ -1859,2611 +2912,5896 @@ tr10:
 			goto _test_eof51
 		}
 	st_case_51:
-		if data[p] == 46 {
-			goto st52
-		}
-		if 48 <= data[p] && data[p] <= 57 {
-			goto st60
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st271
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st271
+			}
+		default:
+			goto st271
 		}
 		goto st0
-	st52:
+	st272:
 		if p++; p == pe {
-			goto _test_eof52
+			goto _test_eof272
 		}
-	st_case_52:
-		if 48 <= data[p] && data[p] <= 57 {
-			goto st53
-		}
-		goto st0
-	st53:
-		if p++; p == pe {
-			goto _test_eof53
-		}
-	st_case_53:
-		if data[p] == 46 {
-			goto st54
-		}
-		if 48 <= data[p] && data[p] <= 57 {
-			goto st58
-		}
-		goto st0
-	st54:
-		if p++; p == pe {
-			goto _test_eof54
-		}
-	st_case_54:
-		if 48 <= data[p] && data[p] <= 57 {
-			goto st55
-		}
-		goto st0
-	st55:
-		if p++; p == pe {
-			goto _test_eof55
-		}
-	st_case_55:
-		if data[p] == 93 {
-			goto st133
-		}
-		if 48 <= data[p] && data[p] <= 57 {
-			goto st56
-		}
-		goto st0
-	st56:
-		if p++; p == pe {
-			goto _test_eof56
-		}
-	st_case_56:
-		if data[p] == 93 {
-			goto st133
-		}
-		if 48 <= data[p] && data[p] <= 57 {
-			goto st57
-		}
-		goto st0
-	st57:
-		if p++; p == pe {
-			goto _test_eof57
-		}
-	st_case_57:
-		if data[p] == 93 {
-			goto st133
-		}
-		goto st0
-	st133:
-		if p++; p == pe {
-			goto _test_eof133
-		}
-	st_case_133:
+	st_case_272:
 		switch data[p] {
-		case 58:
-			goto st18
+		case 45:
+			goto st264
 		case 59:
-			goto tr131
-		case 63:
-			goto tr132
-		}
-		goto st0
-	st58:
-		if p++; p == pe {
-			goto _test_eof58
-		}
-	st_case_58:
-		if data[p] == 46 {
-			goto st54
-		}
-		if 48 <= data[p] && data[p] <= 57 {
-			goto st59
-		}
-		goto st0
-	st59:
-		if p++; p == pe {
-			goto _test_eof59
-		}
-	st_case_59:
-		if data[p] == 46 {
-			goto st54
-		}
-		goto st0
-	st60:
-		if p++; p == pe {
-			goto _test_eof60
-		}
-	st_case_60:
-		if data[p] == 46 {
-			goto st52
-		}
-		if 48 <= data[p] && data[p] <= 57 {
-			goto st61
-		}
-		goto st0
-	st61:
-		if p++; p == pe {
-			goto _test_eof61
+			goto tr285
+		case 61:
+			goto tr286
+		case 72:
+			goto st273
+		case 79:
+			goto st284
+		case 104:
+			goto st273
+		case 111:
+			goto st284
 		}
-	st_case_61:
-		if data[p] == 46 {
-			goto st52
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st264
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st264
+			}
+		default:
+			goto st264
 		}
 		goto st0
-	st62:
+	st273:
 		if p++; p == pe {
-			goto _test_eof62
+			goto _test_eof273
 		}
-	st_case_62:
+	st_case_273:
 		switch data[p] {
-		case 46:
-			goto st50
-		case 58:
-			goto st48
-		case 93:
-			goto st133
+		case 45:
+			goto st264
+		case 59:
+			goto tr285
+		case 61:
+			goto tr286
+		case 79:
+			goto st274
+		case 111:
+			goto st274
 		}
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st63
+				goto st264
 			}
-		case data[p] > 70:
-			if 97 <= data[p] && data[p] <= 102 {
-				goto st46
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st264
 			}
 		default:
-			goto st46
+			goto st264
 		}
 		goto st0
-	st63:
+	st274:
 		if p++; p == pe {
-			goto _test_eof63
+			goto _test_eof274
 		}
-	st_case_63:
+	st_case_274:
 		switch data[p] {
-		case 46:
-			goto st50
-		case 58:
-			goto st48
-		case 93:
-			goto st133
+		case 45:
+			goto st264
+		case 59:
+			goto tr285
+		case 61:
+			goto tr286
+		case 78:
+			goto st275
+		case 110:
+			goto st275
 		}
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st47
+				goto st264
 			}
-		case data[p] > 70:
-			if 97 <= data[p] && data[p] <= 102 {
-				goto st47
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st264
 			}
 		default:
-			goto st47
+			goto st264
 		}
 		goto st0
-	st64:
+	st275:
 		if p++; p == pe {
-			goto _test_eof64
+			goto _test_eof275
 		}
-	st_case_64:
+	st_case_275:
 		switch data[p] {
-		case 58:
-			goto st73
-		case 93:
-			goto st133
+		case 45:
+			goto st264
+		case 59:
+			goto tr285
+		case 61:
+			goto tr286
+		case 69:
+			goto st276
+		case 101:
+			goto st276
 		}
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st65
+				goto st264
 			}
-		case data[p] > 70:
-			if 97 <= data[p] && data[p] <= 102 {
-				goto st65
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st264
 			}
 		default:
-			goto st65
+			goto st264
 		}
 		goto st0
-	st65:
+	st276:
 		if p++; p == pe {
-			goto _test_eof65
+			goto _test_eof276
 		}
-	st_case_65:
+	st_case_276:
 		switch data[p] {
-		case 58:
-			goto st69
-		case 93:
-			goto st133
+		case 45:
+			goto st277
+		case 59:
+			goto tr285
+		case 61:
+			goto tr286
 		}
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st66
+				goto st264
 			}
-		case data[p] > 70:
-			if 97 <= data[p] && data[p] <= 102 {
-				goto st66
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st264
 			}
 		default:
-			goto st66
+			goto st264
 		}
 		goto st0
-	st66:
+	st277:
 		if p++; p == pe {
-			goto _test_eof66
+			goto _test_eof277
 		}
-	st_case_66:
+	st_case_277:
 		switch data[p] {
-		case 58:
-			goto st69
-		case 93:
-			goto st133
+		case 45:
+			goto st264
+		case 59:
+			goto tr285
+		case 61:
+			goto tr286
+		case 67:
+			goto st278
+		case 99:
+			goto st278
 		}
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st67
+				goto st264
 			}
-		case data[p] > 70:
-			if 97 <= data[p] && data[p] <= 102 {
-				goto st67
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st264
 			}
 		default:
-			goto st67
+			goto st264
 		}
 		goto st0
-	st67:
+	st278:
 		if p++; p == pe {
-			goto _test_eof67
+			goto _test_eof278
 		}
-	st_case_67:
+	st_case_278:
 		switch data[p] {
-		case 58:
-			goto st69
-		case 93:
-			goto st133
+		case 45:
+			goto st264
+		case 59:
+			goto tr285
+		case 61:
+			goto tr286
+		case 79:
+			goto st279
+		case 111:
+			goto st279
 		}
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st68
+				goto st264
 			}
-		case data[p] > 70:
-			if 97 <= data[p] && data[p] <= 102 {
-				goto st68
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st264
 			}
 		default:
-			goto st68
+			goto st264
 		}
 		goto st0
-	st68:
+	st279:
 		if p++; p == pe {
-			goto _test_eof68
+			goto _test_eof279
 		}
-	st_case_68:
+	st_case_279:
 		switch data[p] {
-		case 58:
-			goto st69
-		case 93:
-			goto st133
-		}
-		goto st0
-	st69:
-		if p++; p == pe {
-			goto _test_eof69
+		case 45:
+			goto st264
+		case 59:
+			goto tr285
+		case 61:
+			goto tr286
+		case 78:
+			goto st280
+		case 110:
+			goto st280
 		}
-	st_case_69:
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st70
+				goto st264
 			}
-		case data[p] > 70:
-			if 97 <= data[p] && data[p] <= 102 {
-				goto st65
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st264
 			}
 		default:
-			goto st65
+			goto st264
 		}
 		goto st0
-	st70:
+	st280:
 		if p++; p == pe {
-			goto _test_eof70
+			goto _test_eof280
 		}
-	st_case_70:
+	st_case_280:
 		switch data[p] {
-		case 46:
-			goto st50
-		case 58:
-			goto st69
-		case 93:
-			goto st133
+		case 45:
+			goto st264
+		case 59:
+			goto tr285
+		case 61:
+			goto tr286
+		case 84:
+			goto st281
+		case 116:
+			goto st281
 		}
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st71
+				goto st264
 			}
-		case data[p] > 70:
-			if 97 <= data[p] && data[p] <= 102 {
-				goto st66
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st264
 			}
 		default:
-			goto st66
+			goto st264
 		}
 		goto st0
-	st71:
+	st281:
 		if p++; p == pe {
-			goto _test_eof71
+			goto _test_eof281
 		}
-	st_case_71:
+	st_case_281:
 		switch data[p] {
-		case 46:
-			goto st50
-		case 58:
-			goto st69
-		case 93:
-			goto st133
+		case 45:
+			goto st264
+		case 59:
+			goto tr285
+		case 61:
+			goto tr286
+		case 69:
+			goto st282
+		case 101:
+			goto st282
 		}
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st72
+				goto st264
 			}
-		case data[p] > 70:
-			if 97 <= data[p] && data[p] <= 102 {
-				goto st67
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st264
 			}
 		default:
-			goto st67
+			goto st264
 		}
 		goto st0
-	st72:
+	st282:
 		if p++; p == pe {
-			goto _test_eof72
+			goto _test_eof282
 		}
-	st_case_72:
+	st_case_282:
 		switch data[p] {
-		case 46:
-			goto st50
-		case 58:
-			goto st69
-		case 93:
-			goto st133
+		case 45:
+			goto st264
+		case 59:
+			goto tr285
+		case 61:
+			goto tr286
+		case 88:
+			goto st283
+		case 120:
+			goto st283
 		}
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st68
+				goto st264
 			}
-		case data[p] > 70:
-			if 97 <= data[p] && data[p] <= 102 {
-				goto st68
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st264
 			}
 		default:
-			goto st68
+			goto st264
 		}
 		goto st0
-	st73:
+	st283:
 		if p++; p == pe {
-			goto _test_eof73
+			goto _test_eof283
 		}
-	st_case_73:
-		if 48 <= data[p] && data[p] <= 57 {
-			goto st74
+	st_case_283:
+		switch data[p] {
+		case 45:
+			goto st264
+		case 59:
+			goto tr285
+		case 61:
+			goto tr286
+		case 84:
+			goto st52
+		case 116:
+			goto st52
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st264
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st264
+			}
+		default:
+			goto st264
 		}
 		goto st0
-	st74:
+	st52:
 		if p++; p == pe {
-			goto _test_eof74
+			goto _test_eof52
 		}
-	st_case_74:
-		if data[p] == 46 {
-			goto st50
+	st_case_52:
+		if data[p] == 45 {
+			goto st264
 		}
-		if 48 <= data[p] && data[p] <= 57 {
-			goto st75
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st264
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st264
+			}
+		default:
+			goto st264
 		}
 		goto st0
-	st75:
+	st284:
 		if p++; p == pe {
-			goto _test_eof75
+			goto _test_eof284
 		}
-	st_case_75:
-		if data[p] == 46 {
-			goto st50
+	st_case_284:
+		switch data[p] {
+		case 45:
+			goto st264
+		case 59:
+			goto tr285
+		case 61:
+			goto tr286
+		case 83:
+			goto st285
+		case 115:
+			goto st285
 		}
-		if 48 <= data[p] && data[p] <= 57 {
-			goto st76
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st264
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st264
+			}
+		default:
+			goto st264
 		}
 		goto st0
-	st76:
+	st285:
 		if p++; p == pe {
-			goto _test_eof76
-		}
-	st_case_76:
-		if data[p] == 46 {
-			goto st50
+			goto _test_eof285
 		}
-		goto st0
-	st77:
-		if p++; p == pe {
-			goto _test_eof77
+	st_case_285:
+		switch data[p] {
+		case 45:
+			goto st264
+		case 59:
+			goto tr285
+		case 61:
+			goto tr286
+		case 84:
+			goto st286
+		case 116:
+			goto st286
 		}
-	st_case_77:
-		if data[p] == 58 {
-			goto st64
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st264
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st264
+			}
+		default:
+			goto st264
 		}
 		goto st0
-tr8:
-//line parser.rl:20
- m = p 
-	goto st78
-	st78:
+	st286:
 		if p++; p == pe {
-			goto _test_eof78
+			goto _test_eof286
 		}
-	st_case_78:
-//line parser_rl.go:2305
+	st_case_286:
 		switch data[p] {
-		case 33:
-			goto st6
-		case 37:
-			goto st7
 		case 45:
-			goto st79
-		case 46:
-			goto st108
-		case 58:
-			goto st9
+			goto st264
 		case 59:
-			goto st6
+			goto tr285
 		case 61:
-			goto st6
-		case 63:
-			goto st6
-		case 64:
-			goto tr14
-		case 95:
-			goto st6
-		case 126:
-			goto st6
+			goto tr286
+		case 68:
+			goto st53
+		case 100:
+			goto st53
 		}
 		switch {
-		case data[p] < 48:
-			if 36 <= data[p] && data[p] <= 47 {
-				goto st6
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st264
 			}
-		case data[p] > 57:
-			switch {
-			case data[p] > 90:
-				if 97 <= data[p] && data[p] <= 122 {
-					goto st80
-				}
-			case data[p] >= 65:
-				goto st80
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st264
 			}
 		default:
-			goto st117
+			goto st264
 		}
 		goto st0
-	st79:
+	st53:
 		if p++; p == pe {
-			goto _test_eof79
+			goto _test_eof53
 		}
-	st_case_79:
+	st_case_53:
 		switch data[p] {
-		case 33:
-			goto st6
-		case 37:
-			goto st7
 		case 45:
-			goto st79
-		case 58:
-			goto st9
-		case 59:
-			goto st6
+			goto st264
 		case 61:
-			goto st6
-		case 63:
-			goto st6
-		case 64:
-			goto tr14
-		case 95:
-			goto st6
-		case 126:
-			goto st6
+			goto tr66
 		}
 		switch {
-		case data[p] < 48:
-			if 36 <= data[p] && data[p] <= 47 {
-				goto st6
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st264
 			}
-		case data[p] > 57:
-			switch {
-			case data[p] > 90:
-				if 97 <= data[p] && data[p] <= 122 {
-					goto st80
-				}
-			case data[p] >= 65:
-				goto st80
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st264
 			}
 		default:
-			goto st80
+			goto st264
 		}
 		goto st0
-	st80:
+tr66:
+//line parser.rl:51
+ e = p 
+//line parser.rl:34
+ if postd { {cs = (uri_error); goto _again } }; postd = true 
+	goto st54
+	st54:
 		if p++; p == pe {
-			goto _test_eof80
+			goto _test_eof54
 		}
-	st_case_80:
+	st_case_54:
+//line parser_rl.go:3435
 		switch data[p] {
-		case 33:
-			goto st6
 		case 37:
-			goto st7
-		case 45:
-			goto st79
-		case 46:
-			goto st81
-		case 58:
-			goto st9
-		case 59:
-			goto st6
-		case 61:
-			goto st6
-		case 63:
-			goto st6
-		case 64:
-			goto tr14
-		case 95:
-			goto st6
-		case 126:
-			goto st6
+			goto st55
+		case 80:
+			goto st287
+		case 87:
+			goto st287
+		case 112:
+			goto st287
+		case 119:
+			goto st287
 		}
 		switch {
 		case data[p] < 48:
-			if 36 <= data[p] && data[p] <= 47 {
-				goto st6
+			switch {
+			case data[p] > 42:
+				if 45 <= data[p] && data[p] <= 46 {
+					goto st287
+				}
+			case data[p] >= 40:
+				goto st287
 			}
 		case data[p] > 57:
 			switch {
-			case data[p] > 90:
-				if 97 <= data[p] && data[p] <= 122 {
-					goto st80
+			case data[p] > 68:
+				if 97 <= data[p] && data[p] <= 100 {
+					goto st287
 				}
 			case data[p] >= 65:
-				goto st80
+				goto st287
 			}
 		default:
-			goto st80
+			goto st287
 		}
 		goto st0
-	st81:
+	st55:
 		if p++; p == pe {
-			goto _test_eof81
+			goto _test_eof55
 		}
-	st_case_81:
+	st_case_55:
+		if data[p] == 50 {
+			goto st56
+		}
+		goto st0
+	st56:
+		if p++; p == pe {
+			goto _test_eof56
+		}
+	st_case_56:
+		if data[p] == 51 {
+			goto st287
+		}
+		goto st0
+	st287:
+		if p++; p == pe {
+			goto _test_eof287
+		}
+	st_case_287:
 		switch data[p] {
-		case 33:
-			goto st6
 		case 37:
-			goto st7
-		case 58:
-			goto st9
+			goto st55
 		case 59:
-			goto st6
-		case 61:
-			goto st6
-		case 63:
-			goto st6
-		case 64:
-			goto tr14
-		case 95:
-			goto st6
-		case 126:
-			goto st6
+			goto tr284
+		case 80:
+			goto st287
+		case 87:
+			goto st287
+		case 112:
+			goto st287
+		case 119:
+			goto st287
 		}
 		switch {
 		case data[p] < 48:
-			if 36 <= data[p] && data[p] <= 47 {
-				goto st6
+			switch {
+			case data[p] > 42:
+				if 45 <= data[p] && data[p] <= 46 {
+					goto st287
+				}
+			case data[p] >= 40:
+				goto st287
 			}
 		case data[p] > 57:
 			switch {
-			case data[p] > 90:
-				if 97 <= data[p] && data[p] <= 122 {
-					goto st134
+			case data[p] > 68:
+				if 97 <= data[p] && data[p] <= 100 {
+					goto st287
 				}
 			case data[p] >= 65:
-				goto st134
+				goto st287
 			}
 		default:
-			goto st80
+			goto st287
 		}
 		goto st0
-tr9:
-//line parser.rl:20
- m = p 
-	goto st134
-	st134:
+	st57:
 		if p++; p == pe {
-			goto _test_eof134
+			goto _test_eof57
 		}
-	st_case_134:
-//line parser_rl.go:2492
+	st_case_57:
 		switch data[p] {
-		case 33:
-			goto st6
-		case 37:
-			goto st7
 		case 45:
-			goto st82
+			goto st58
 		case 46:
-			goto st135
-		case 58:
-			goto st83
+			goto st59
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st57
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st57
+			}
+		default:
+			goto st57
+		}
+		goto st0
+	st58:
+		if p++; p == pe {
+			goto _test_eof58
+		}
+	st_case_58:
+		if data[p] == 45 {
+			goto st58
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st57
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st57
+			}
+		default:
+			goto st57
+		}
+		goto st0
+	st59:
+		if p++; p == pe {
+			goto _test_eof59
+		}
+	st_case_59:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st57
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st288
+			}
+		default:
+			goto st288
+		}
+		goto st0
+	st288:
+		if p++; p == pe {
+			goto _test_eof288
+		}
+	st_case_288:
+		switch data[p] {
+		case 45:
+			goto st60
+		case 46:
+			goto st289
+		case 59:
+			goto tr284
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st288
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st288
+			}
+		default:
+			goto st288
+		}
+		goto st0
+	st60:
+		if p++; p == pe {
+			goto _test_eof60
+		}
+	st_case_60:
+		if data[p] == 45 {
+			goto st60
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st288
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st288
+			}
+		default:
+			goto st288
+		}
+		goto st0
+	st289:
+		if p++; p == pe {
+			goto _test_eof289
+		}
+	st_case_289:
+		if data[p] == 59 {
+			goto tr284
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st57
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st288
+			}
+		default:
+			goto st288
+		}
+		goto st0
+	st61:
+		if p++; p == pe {
+			goto _test_eof61
+		}
+	st_case_61:
+		switch data[p] {
+		case 45:
+			goto st10
 		case 59:
-			goto tr146
+			goto tr18
 		case 61:
-			goto st6
-		case 63:
-			goto tr147
-		case 64:
-			goto tr14
-		case 95:
-			goto st6
-		case 126:
-			goto st6
+			goto tr19
+		case 83:
+			goto st62
+		case 115:
+			goto st62
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st10
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st10
+			}
+		default:
+			goto st10
+		}
+		goto st0
+	st62:
+		if p++; p == pe {
+			goto _test_eof62
+		}
+	st_case_62:
+		switch data[p] {
+		case 45:
+			goto st10
+		case 59:
+			goto tr18
+		case 61:
+			goto tr19
+		case 84:
+			goto st63
+		case 116:
+			goto st63
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st10
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st10
+			}
+		default:
+			goto st10
+		}
+		goto st0
+	st63:
+		if p++; p == pe {
+			goto _test_eof63
+		}
+	st_case_63:
+		switch data[p] {
+		case 45:
+			goto st10
+		case 59:
+			goto tr18
+		case 61:
+			goto tr19
+		case 68:
+			goto st64
+		case 100:
+			goto st64
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st10
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st10
+			}
+		default:
+			goto st10
+		}
+		goto st0
+	st64:
+		if p++; p == pe {
+			goto _test_eof64
+		}
+	st_case_64:
+		switch data[p] {
+		case 45:
+			goto st10
+		case 61:
+			goto tr76
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st10
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st10
+			}
+		default:
+			goto st10
+		}
+		goto st0
+tr76:
+//line parser.rl:51
+ e = p 
+//line parser.rl:34
+ if postd { {cs = (uri_error); goto _again } }; postd = true 
+	goto st65
+	st65:
+		if p++; p == pe {
+			goto _test_eof65
+		}
+	st_case_65:
+//line parser_rl.go:3787
+		switch data[p] {
+		case 37:
+			goto st66
+		case 80:
+			goto st68
+		case 87:
+			goto st68
+		case 112:
+			goto st68
+		case 119:
+			goto st68
+		}
+		switch {
+		case data[p] < 48:
+			switch {
+			case data[p] > 42:
+				if 45 <= data[p] && data[p] <= 46 {
+					goto st68
+				}
+			case data[p] >= 40:
+				goto st68
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 68:
+				if 97 <= data[p] && data[p] <= 100 {
+					goto st68
+				}
+			case data[p] >= 65:
+				goto st68
+			}
+		default:
+			goto st68
+		}
+		goto st0
+	st66:
+		if p++; p == pe {
+			goto _test_eof66
+		}
+	st_case_66:
+		if data[p] == 50 {
+			goto st67
+		}
+		goto st0
+	st67:
+		if p++; p == pe {
+			goto _test_eof67
+		}
+	st_case_67:
+		if data[p] == 51 {
+			goto st68
+		}
+		goto st0
+	st68:
+		if p++; p == pe {
+			goto _test_eof68
+		}
+	st_case_68:
+		switch data[p] {
+		case 37:
+			goto st66
+		case 59:
+			goto tr22
+		case 80:
+			goto st68
+		case 87:
+			goto st68
+		case 112:
+			goto st68
+		case 119:
+			goto st68
+		}
+		switch {
+		case data[p] < 48:
+			switch {
+			case data[p] > 42:
+				if 45 <= data[p] && data[p] <= 46 {
+					goto st68
+				}
+			case data[p] >= 40:
+				goto st68
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 68:
+				if 97 <= data[p] && data[p] <= 100 {
+					goto st68
+				}
+			case data[p] >= 65:
+				goto st68
+			}
+		default:
+			goto st68
+		}
+		goto st0
+tr7:
+//line parser.rl:25
+ m = p 
+	goto st69
+	st69:
+		if p++; p == pe {
+			goto _test_eof69
+		}
+	st_case_69:
+//line parser_rl.go:3892
+		switch data[p] {
+		case 37:
+			goto st6
+		case 42:
+			goto st8
+		}
+		switch {
+		case data[p] < 48:
+			switch {
+			case data[p] > 41:
+				if 45 <= data[p] && data[p] <= 46 {
+					goto st69
+				}
+			case data[p] >= 40:
+				goto st69
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st8
+				}
+			case data[p] >= 65:
+				goto st8
+			}
+		default:
+			goto st8
+		}
+		goto st0
+tr9:
+//line parser.rl:25
+ m = p 
+	goto st70
+	st70:
+		if p++; p == pe {
+			goto _test_eof70
+		}
+	st_case_70:
+//line parser_rl.go:3931
+		switch {
+		case data[p] < 45:
+			if 40 <= data[p] && data[p] <= 41 {
+				goto st70
+			}
+		case data[p] > 46:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st290
+			}
+		default:
+			goto st70
+		}
+		goto st0
+	st290:
+		if p++; p == pe {
+			goto _test_eof290
+		}
+	st_case_290:
+		if data[p] == 59 {
+			goto tr309
+		}
+		switch {
+		case data[p] < 45:
+			if 40 <= data[p] && data[p] <= 41 {
+				goto st290
+			}
+		case data[p] > 46:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st290
+			}
+		default:
+			goto st290
+		}
+		goto st0
+	st71:
+		if p++; p == pe {
+			goto _test_eof71
+		}
+	st_case_71:
+		if data[p] == 105 {
+			goto st72
+		}
+		goto st0
+	st72:
+		if p++; p == pe {
+			goto _test_eof72
+		}
+	st_case_72:
+		if data[p] == 112 {
+			goto st73
+		}
+		goto st0
+	st73:
+		if p++; p == pe {
+			goto _test_eof73
+		}
+	st_case_73:
+		switch data[p] {
+		case 58:
+			goto tr85
+		case 115:
+			goto st81
+		}
+		goto st0
+tr85:
+//line parser.rl:26
+ uri.scheme   = SIP;  u = p + 1 
+//line parser.rl:36
+ if strings.IndexByte(str[p+1:], '@') < 0 { {cs = (uri_en_hostpart); goto _again } } 
+	goto st74
+tr96:
+//line parser.rl:27
+ uri.scheme   = SIPS; u = p + 1 
+//line parser.rl:36
+ if strings.IndexByte(str[p+1:], '@') < 0 { {cs = (uri_en_hostpart); goto _again } } 
+	goto st74
+	st74:
+		if p++; p == pe {
+			goto _test_eof74
+		}
+	st_case_74:
+//line parser_rl.go:4013
+		switch data[p] {
+		case 33:
+			goto tr87
+		case 37:
+			goto tr88
+		case 59:
+			goto tr87
+		case 61:
+			goto tr87
+		case 63:
+			goto tr87
+		case 95:
+			goto tr87
+		case 126:
+			goto tr87
+		}
+		switch {
+		case data[p] < 65:
+			if 36 <= data[p] && data[p] <= 57 {
+				goto tr87
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto tr87
+			}
+		default:
+			goto tr87
+		}
+		goto st0
+tr87:
+//line parser.rl:25
+ m = p 
+	goto st75
+	st75:
+		if p++; p == pe {
+			goto _test_eof75
+		}
+	st_case_75:
+//line parser_rl.go:4052
+		switch data[p] {
+		case 33:
+			goto st75
+		case 37:
+			goto st76
+		case 58:
+			goto st78
+		case 61:
+			goto st75
+		case 64:
+			goto tr92
+		case 95:
+			goto st75
+		case 126:
+			goto st75
+		}
+		switch {
+		case data[p] < 63:
+			if 36 <= data[p] && data[p] <= 59 {
+				goto st75
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st75
+			}
+		default:
+			goto st75
+		}
+		goto st0
+tr88:
+//line parser.rl:25
+ m = p 
+	goto st76
+	st76:
+		if p++; p == pe {
+			goto _test_eof76
+		}
+	st_case_76:
+//line parser_rl.go:4091
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st77
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st77
+			}
+		default:
+			goto st77
+		}
+		goto st0
+	st77:
+		if p++; p == pe {
+			goto _test_eof77
+		}
+	st_case_77:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st75
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st75
+			}
+		default:
+			goto st75
+		}
+		goto st0
+	st78:
+		if p++; p == pe {
+			goto _test_eof78
+		}
+	st_case_78:
+		switch data[p] {
+		case 33:
+			goto st78
+		case 37:
+			goto st79
+		case 61:
+			goto st78
+		case 64:
+			goto tr92
+		case 95:
+			goto st78
+		case 126:
+			goto st78
+		}
+		switch {
+		case data[p] < 48:
+			if 36 <= data[p] && data[p] <= 46 {
+				goto st78
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st78
+				}
+			case data[p] >= 65:
+				goto st78
+			}
+		default:
+			goto st78
+		}
+		goto st0
+	st79:
+		if p++; p == pe {
+			goto _test_eof79
+		}
+	st_case_79:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st80
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st80
+			}
+		default:
+			goto st80
+		}
+		goto st0
+	st80:
+		if p++; p == pe {
+			goto _test_eof80
+		}
+	st_case_80:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st78
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st78
+			}
+		default:
+			goto st78
+		}
+		goto st0
+tr92:
+	cs = 291
+//line parser.rl:37
+ uri.userinfo = str[u:p] 
+//line parser.rl:38
+ cs = (uri_en_hostpart); 
+	goto _again
+	st291:
+		if p++; p == pe {
+			goto _test_eof291
+		}
+	st_case_291:
+//line parser_rl.go:4208
+		goto st0
+	st81:
+		if p++; p == pe {
+			goto _test_eof81
+		}
+	st_case_81:
+		if data[p] == 58 {
+			goto tr96
+		}
+		goto st0
+	st82:
+		if p++; p == pe {
+			goto _test_eof82
+		}
+	st_case_82:
+		switch data[p] {
+		case 50:
+			goto tr98
+		case 91:
+			goto tr101
+		}
+		switch {
+		case data[p] < 51:
+			if 48 <= data[p] && data[p] <= 49 {
+				goto tr97
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto tr100
+				}
+			case data[p] >= 65:
+				goto tr100
+			}
+		default:
+			goto tr99
+		}
+		goto st0
+tr97:
+//line parser.rl:25
+ m = p 
+	goto st83
+	st83:
+		if p++; p == pe {
+			goto _test_eof83
+		}
+	st_case_83:
+//line parser_rl.go:4257
+		switch data[p] {
+		case 45:
+			goto st84
+		case 46:
+			goto st102
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st115
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st85
+			}
+		default:
+			goto st85
+		}
+		goto st0
+	st84:
+		if p++; p == pe {
+			goto _test_eof84
+		}
+	st_case_84:
+		if data[p] == 45 {
+			goto st84
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st85
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st85
+			}
+		default:
+			goto st85
+		}
+		goto st0
+	st85:
+		if p++; p == pe {
+			goto _test_eof85
+		}
+	st_case_85:
+		switch data[p] {
+		case 45:
+			goto st84
+		case 46:
+			goto st86
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st85
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st85
+			}
+		default:
+			goto st85
+		}
+		goto st0
+	st86:
+		if p++; p == pe {
+			goto _test_eof86
+		}
+	st_case_86:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st85
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st292
+			}
+		default:
+			goto st292
+		}
+		goto st0
+tr100:
+//line parser.rl:25
+ m = p 
+	goto st292
+	st292:
+		if p++; p == pe {
+			goto _test_eof292
+		}
+	st_case_292:
+//line parser_rl.go:4349
+		switch data[p] {
+		case 45:
+			goto st87
+		case 46:
+			goto st293
+		case 58:
+			goto st88
+		case 59:
+			goto tr312
+		case 63:
+			goto tr313
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st292
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st292
+			}
+		default:
+			goto st292
+		}
+		goto st0
+	st87:
+		if p++; p == pe {
+			goto _test_eof87
+		}
+	st_case_87:
+		if data[p] == 45 {
+			goto st87
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st292
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st292
+			}
+		default:
+			goto st292
+		}
+		goto st0
+	st293:
+		if p++; p == pe {
+			goto _test_eof293
+		}
+	st_case_293:
+		switch data[p] {
+		case 58:
+			goto st88
+		case 59:
+			goto tr312
+		case 63:
+			goto tr313
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st85
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st292
+			}
+		default:
+			goto st292
+		}
+		goto st0
+	st88:
+		if p++; p == pe {
+			goto _test_eof88
+		}
+	st_case_88:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr109
+		}
+		goto st0
+tr109:
+//line parser.rl:39
+ o = p; port = 0 
+//line parser.rl:41
+
+		if port = port*10 + int(str[p]-'0'); port > 0xFFFF {
+			p = o
+			{cs = (uri_error); goto _again }
+		}
+	
+	goto st294
+tr314:
+//line parser.rl:41
+
+		if port = port*10 + int(str[p]-'0'); port > 0xFFFF {
+			p = o
+			{cs = (uri_error); goto _again }
+		}
+	
+	goto st294
+	st294:
+		if p++; p == pe {
+			goto _test_eof294
+		}
+	st_case_294:
+//line parser_rl.go:4456
+		switch data[p] {
+		case 59:
+			goto tr312
+		case 63:
+			goto tr313
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr314
+		}
+		goto st0
+tr312:
+//line parser.rl:47
+ uri.hostport = str[m:p] 
+//line parser.rl:25
+ m = p 
+	goto st89
+tr315:
+//line parser.rl:51
+ e = p 
+//line parser.rl:52
+ uri.spans.add(e-m-1, p-m-1) 
+	goto st89
+tr318:
+//line parser.rl:52
+ uri.spans.add(e-m-1, p-m-1) 
+	goto st89
+	st89:
+		if p++; p == pe {
+			goto _test_eof89
+		}
+	st_case_89:
+//line parser_rl.go:4488
+		switch data[p] {
+		case 33:
+			goto st295
+		case 37:
+			goto st90
+		case 93:
+			goto st295
+		case 95:
+			goto st295
+		case 126:
+			goto st295
+		}
+		switch {
+		case data[p] < 45:
+			if 36 <= data[p] && data[p] <= 43 {
+				goto st295
+			}
+		case data[p] > 58:
+			switch {
+			case data[p] > 91:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st295
+				}
+			case data[p] >= 65:
+				goto st295
+			}
+		default:
+			goto st295
+		}
+		goto st0
+	st295:
+		if p++; p == pe {
+			goto _test_eof295
+		}
+	st_case_295:
+		switch data[p] {
+		case 33:
+			goto st295
+		case 37:
+			goto st90
+		case 59:
+			goto tr315
+		case 61:
+			goto tr316
+		case 63:
+			goto tr317
+		case 93:
+			goto st295
+		case 95:
+			goto st295
+		case 126:
+			goto st295
+		}
+		switch {
+		case data[p] < 45:
+			if 36 <= data[p] && data[p] <= 43 {
+				goto st295
+			}
+		case data[p] > 58:
+			switch {
+			case data[p] > 91:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st295
+				}
+			case data[p] >= 65:
+				goto st295
+			}
+		default:
+			goto st295
+		}
+		goto st0
+	st90:
+		if p++; p == pe {
+			goto _test_eof90
+		}
+	st_case_90:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st91
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st91
+			}
+		default:
+			goto st91
+		}
+		goto st0
+	st91:
+		if p++; p == pe {
+			goto _test_eof91
+		}
+	st_case_91:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st295
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st295
+			}
+		default:
+			goto st295
+		}
+		goto st0
+tr316:
+//line parser.rl:51
+ e = p 
+	goto st92
+	st92:
+		if p++; p == pe {
+			goto _test_eof92
+		}
+	st_case_92:
+//line parser_rl.go:4605
+		switch data[p] {
+		case 33:
+			goto st296
+		case 37:
+			goto st93
+		case 93:
+			goto st296
+		case 95:
+			goto st296
+		case 126:
+			goto st296
+		}
+		switch {
+		case data[p] < 45:
+			if 36 <= data[p] && data[p] <= 43 {
+				goto st296
+			}
+		case data[p] > 58:
+			switch {
+			case data[p] > 91:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st296
+				}
+			case data[p] >= 65:
+				goto st296
+			}
+		default:
+			goto st296
+		}
+		goto st0
+	st296:
+		if p++; p == pe {
+			goto _test_eof296
+		}
+	st_case_296:
+		switch data[p] {
+		case 33:
+			goto st296
+		case 37:
+			goto st93
+		case 59:
+			goto tr318
+		case 63:
+			goto tr319
+		case 93:
+			goto st296
+		case 95:
+			goto st296
+		case 126:
+			goto st296
+		}
+		switch {
+		case data[p] < 45:
+			if 36 <= data[p] && data[p] <= 43 {
+				goto st296
+			}
+		case data[p] > 58:
+			switch {
+			case data[p] > 91:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st296
+				}
+			case data[p] >= 65:
+				goto st296
+			}
+		default:
+			goto st296
+		}
+		goto st0
+	st93:
+		if p++; p == pe {
+			goto _test_eof93
+		}
+	st_case_93:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st94
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st94
+			}
+		default:
+			goto st94
+		}
+		goto st0
+	st94:
+		if p++; p == pe {
+			goto _test_eof94
+		}
+	st_case_94:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st296
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st296
+			}
+		default:
+			goto st296
+		}
+		goto st0
+tr313:
+//line parser.rl:47
+ uri.hostport = str[m:p] 
+//line parser.rl:25
+ m = p 
+//line parser.rl:48
+ uri.params   = strings.TrimPrefix(str[m:p], ";") 
+	goto st95
+tr317:
+//line parser.rl:51
+ e = p 
+//line parser.rl:52
+ uri.spans.add(e-m-1, p-m-1) 
+//line parser.rl:48
+ uri.params   = strings.TrimPrefix(str[m:p], ";") 
+	goto st95
+tr319:
+//line parser.rl:52
+ uri.spans.add(e-m-1, p-m-1) 
+//line parser.rl:48
+ uri.params   = strings.TrimPrefix(str[m:p], ";") 
+	goto st95
+	st95:
+		if p++; p == pe {
+			goto _test_eof95
+		}
+	st_case_95:
+//line parser_rl.go:4738
+		switch data[p] {
+		case 33:
+			goto tr116
+		case 36:
+			goto tr116
+		case 37:
+			goto tr117
+		case 63:
+			goto tr116
+		case 93:
+			goto tr116
+		case 95:
+			goto tr116
+		case 126:
+			goto tr116
+		}
+		switch {
+		case data[p] < 45:
+			if 39 <= data[p] && data[p] <= 43 {
+				goto tr116
+			}
+		case data[p] > 58:
+			switch {
+			case data[p] > 91:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto tr116
+				}
+			case data[p] >= 65:
+				goto tr116
+			}
+		default:
+			goto tr116
+		}
+		goto st0
+tr116:
+//line parser.rl:25
+ m = p 
+	goto st96
+	st96:
+		if p++; p == pe {
+			goto _test_eof96
+		}
+	st_case_96:
+//line parser_rl.go:4782
+		switch data[p] {
+		case 33:
+			goto st96
+		case 36:
+			goto st96
+		case 37:
+			goto st97
+		case 61:
+			goto st297
+		case 63:
+			goto st96
+		case 93:
+			goto st96
+		case 95:
+			goto st96
+		case 126:
+			goto st96
+		}
+		switch {
+		case data[p] < 45:
+			if 39 <= data[p] && data[p] <= 43 {
+				goto st96
+			}
+		case data[p] > 58:
+			switch {
+			case data[p] > 91:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st96
+				}
+			case data[p] >= 65:
+				goto st96
+			}
+		default:
+			goto st96
+		}
+		goto st0
+tr117:
+//line parser.rl:25
+ m = p 
+	goto st97
+	st97:
+		if p++; p == pe {
+			goto _test_eof97
+		}
+	st_case_97:
+//line parser_rl.go:4828
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st98
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st98
+			}
+		default:
+			goto st98
+		}
+		goto st0
+	st98:
+		if p++; p == pe {
+			goto _test_eof98
+		}
+	st_case_98:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st96
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st96
+			}
+		default:
+			goto st96
+		}
+		goto st0
+	st297:
+		if p++; p == pe {
+			goto _test_eof297
+		}
+	st_case_297:
+		switch data[p] {
+		case 33:
+			goto st297
+		case 37:
+			goto st99
+		case 38:
+			goto st101
+		case 63:
+			goto st297
+		case 93:
+			goto st297
+		case 95:
+			goto st297
+		case 126:
+			goto st297
+		}
+		switch {
+		case data[p] < 45:
+			if 36 <= data[p] && data[p] <= 43 {
+				goto st297
+			}
+		case data[p] > 58:
+			switch {
+			case data[p] > 91:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st297
+				}
+			case data[p] >= 65:
+				goto st297
+			}
+		default:
+			goto st297
+		}
+		goto st0
+	st99:
+		if p++; p == pe {
+			goto _test_eof99
+		}
+	st_case_99:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st100
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st100
+			}
+		default:
+			goto st100
+		}
+		goto st0
+	st100:
+		if p++; p == pe {
+			goto _test_eof100
+		}
+	st_case_100:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st297
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st297
+			}
+		default:
+			goto st297
+		}
+		goto st0
+	st101:
+		if p++; p == pe {
+			goto _test_eof101
+		}
+	st_case_101:
+		switch data[p] {
+		case 33:
+			goto st96
+		case 36:
+			goto st96
+		case 37:
+			goto st97
+		case 63:
+			goto st96
+		case 93:
+			goto st96
+		case 95:
+			goto st96
+		case 126:
+			goto st96
+		}
+		switch {
+		case data[p] < 45:
+			if 39 <= data[p] && data[p] <= 43 {
+				goto st96
+			}
+		case data[p] > 58:
+			switch {
+			case data[p] > 91:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st96
+				}
+			case data[p] >= 65:
+				goto st96
+			}
+		default:
+			goto st96
+		}
+		goto st0
+	st102:
+		if p++; p == pe {
+			goto _test_eof102
+		}
+	st_case_102:
+		if data[p] == 50 {
+			goto st113
+		}
+		switch {
+		case data[p] < 51:
+			if 48 <= data[p] && data[p] <= 49 {
+				goto st103
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st292
+				}
+			case data[p] >= 65:
+				goto st292
+			}
+		default:
+			goto st111
+		}
+		goto st0
+	st103:
+		if p++; p == pe {
+			goto _test_eof103
+		}
+	st_case_103:
+		switch data[p] {
+		case 45:
+			goto st84
+		case 46:
+			goto st104
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st111
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st85
+			}
+		default:
+			goto st85
+		}
+		goto st0
+	st104:
+		if p++; p == pe {
+			goto _test_eof104
+		}
+	st_case_104:
+		if data[p] == 50 {
+			goto st109
+		}
+		switch {
+		case data[p] < 51:
+			if 48 <= data[p] && data[p] <= 49 {
+				goto st105
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st292
+				}
+			case data[p] >= 65:
+				goto st292
+			}
+		default:
+			goto st107
+		}
+		goto st0
+	st105:
+		if p++; p == pe {
+			goto _test_eof105
+		}
+	st_case_105:
+		switch data[p] {
+		case 45:
+			goto st84
+		case 46:
+			goto st106
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st107
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st85
+			}
+		default:
+			goto st85
+		}
+		goto st0
+	st106:
+		if p++; p == pe {
+			goto _test_eof106
+		}
+	st_case_106:
+		if data[p] == 50 {
+			goto st301
+		}
+		switch {
+		case data[p] < 51:
+			if 48 <= data[p] && data[p] <= 49 {
+				goto st298
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st292
+				}
+			case data[p] >= 65:
+				goto st292
+			}
+		default:
+			goto st299
+		}
+		goto st0
+	st298:
+		if p++; p == pe {
+			goto _test_eof298
+		}
+	st_case_298:
+		switch data[p] {
+		case 45:
+			goto st84
+		case 46:
+			goto st86
+		case 58:
+			goto st88
+		case 59:
+			goto tr312
+		case 63:
+			goto tr313
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st299
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st85
+			}
+		default:
+			goto st85
+		}
+		goto st0
+	st299:
+		if p++; p == pe {
+			goto _test_eof299
+		}
+	st_case_299:
+		switch data[p] {
+		case 45:
+			goto st84
+		case 46:
+			goto st86
+		case 58:
+			goto st88
+		case 59:
+			goto tr312
+		case 63:
+			goto tr313
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st300
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st85
+			}
+		default:
+			goto st85
+		}
+		goto st0
+	st300:
+		if p++; p == pe {
+			goto _test_eof300
+		}
+	st_case_300:
+		switch data[p] {
+		case 45:
+			goto st84
+		case 46:
+			goto st86
+		case 58:
+			goto st88
+		case 59:
+			goto tr312
+		case 63:
+			goto tr313
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st85
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st85
+			}
+		default:
+			goto st85
+		}
+		goto st0
+	st301:
+		if p++; p == pe {
+			goto _test_eof301
+		}
+	st_case_301:
+		switch data[p] {
+		case 45:
+			goto st84
+		case 46:
+			goto st86
+		case 53:
+			goto st302
+		case 58:
+			goto st88
+		case 59:
+			goto tr312
+		case 63:
+			goto tr313
+		}
+		switch {
+		case data[p] < 54:
+			if 48 <= data[p] && data[p] <= 52 {
+				goto st299
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st85
+				}
+			case data[p] >= 65:
+				goto st85
+			}
+		default:
+			goto st300
+		}
+		goto st0
+	st302:
+		if p++; p == pe {
+			goto _test_eof302
+		}
+	st_case_302:
+		switch data[p] {
+		case 45:
+			goto st84
+		case 46:
+			goto st86
+		case 58:
+			goto st88
+		case 59:
+			goto tr312
+		case 63:
+			goto tr313
+		}
+		switch {
+		case data[p] < 54:
+			if 48 <= data[p] && data[p] <= 53 {
+				goto st300
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st85
+				}
+			case data[p] >= 65:
+				goto st85
+			}
+		default:
+			goto st85
+		}
+		goto st0
+	st107:
+		if p++; p == pe {
+			goto _test_eof107
+		}
+	st_case_107:
+		switch data[p] {
+		case 45:
+			goto st84
+		case 46:
+			goto st106
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st108
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st85
+			}
+		default:
+			goto st85
+		}
+		goto st0
+	st108:
+		if p++; p == pe {
+			goto _test_eof108
+		}
+	st_case_108:
+		switch data[p] {
+		case 45:
+			goto st84
+		case 46:
+			goto st106
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st85
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st85
+			}
+		default:
+			goto st85
+		}
+		goto st0
+	st109:
+		if p++; p == pe {
+			goto _test_eof109
+		}
+	st_case_109:
+		switch data[p] {
+		case 45:
+			goto st84
+		case 46:
+			goto st106
+		case 53:
+			goto st110
+		}
+		switch {
+		case data[p] < 54:
+			if 48 <= data[p] && data[p] <= 52 {
+				goto st107
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st85
+				}
+			case data[p] >= 65:
+				goto st85
+			}
+		default:
+			goto st108
+		}
+		goto st0
+	st110:
+		if p++; p == pe {
+			goto _test_eof110
+		}
+	st_case_110:
+		switch data[p] {
+		case 45:
+			goto st84
+		case 46:
+			goto st106
+		}
+		switch {
+		case data[p] < 54:
+			if 48 <= data[p] && data[p] <= 53 {
+				goto st108
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st85
+				}
+			case data[p] >= 65:
+				goto st85
+			}
+		default:
+			goto st85
+		}
+		goto st0
+	st111:
+		if p++; p == pe {
+			goto _test_eof111
+		}
+	st_case_111:
+		switch data[p] {
+		case 45:
+			goto st84
+		case 46:
+			goto st104
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st112
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st85
+			}
+		default:
+			goto st85
+		}
+		goto st0
+	st112:
+		if p++; p == pe {
+			goto _test_eof112
+		}
+	st_case_112:
+		switch data[p] {
+		case 45:
+			goto st84
+		case 46:
+			goto st104
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st85
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st85
+			}
+		default:
+			goto st85
+		}
+		goto st0
+	st113:
+		if p++; p == pe {
+			goto _test_eof113
+		}
+	st_case_113:
+		switch data[p] {
+		case 45:
+			goto st84
+		case 46:
+			goto st104
+		case 53:
+			goto st114
+		}
+		switch {
+		case data[p] < 54:
+			if 48 <= data[p] && data[p] <= 52 {
+				goto st111
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st85
+				}
+			case data[p] >= 65:
+				goto st85
+			}
+		default:
+			goto st112
+		}
+		goto st0
+	st114:
+		if p++; p == pe {
+			goto _test_eof114
+		}
+	st_case_114:
+		switch data[p] {
+		case 45:
+			goto st84
+		case 46:
+			goto st104
+		}
+		switch {
+		case data[p] < 54:
+			if 48 <= data[p] && data[p] <= 53 {
+				goto st112
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st85
+				}
+			case data[p] >= 65:
+				goto st85
+			}
+		default:
+			goto st85
+		}
+		goto st0
+tr99:
+//line parser.rl:25
+ m = p 
+	goto st115
+	st115:
+		if p++; p == pe {
+			goto _test_eof115
+		}
+	st_case_115:
+//line parser_rl.go:5487
+		switch data[p] {
+		case 45:
+			goto st84
+		case 46:
+			goto st102
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st116
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st85
+			}
+		default:
+			goto st85
+		}
+		goto st0
+	st116:
+		if p++; p == pe {
+			goto _test_eof116
+		}
+	st_case_116:
+		switch data[p] {
+		case 45:
+			goto st84
+		case 46:
+			goto st102
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st85
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st85
+			}
+		default:
+			goto st85
+		}
+		goto st0
+tr98:
+//line parser.rl:25
+ m = p 
+	goto st117
+	st117:
+		if p++; p == pe {
+			goto _test_eof117
+		}
+	st_case_117:
+//line parser_rl.go:5540
+		switch data[p] {
+		case 45:
+			goto st84
+		case 46:
+			goto st102
+		case 53:
+			goto st118
+		}
+		switch {
+		case data[p] < 54:
+			if 48 <= data[p] && data[p] <= 52 {
+				goto st115
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st85
+				}
+			case data[p] >= 65:
+				goto st85
+			}
+		default:
+			goto st116
+		}
+		goto st0
+	st118:
+		if p++; p == pe {
+			goto _test_eof118
+		}
+	st_case_118:
+		switch data[p] {
+		case 45:
+			goto st84
+		case 46:
+			goto st102
+		}
+		switch {
+		case data[p] < 54:
+			if 48 <= data[p] && data[p] <= 53 {
+				goto st116
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st85
+				}
+			case data[p] >= 65:
+				goto st85
+			}
+		default:
+			goto st85
+		}
+		goto st0
+tr101:
+//line parser.rl:25
+ m = p 
+	goto st119
+	st119:
+		if p++; p == pe {
+			goto _test_eof119
+		}
+	st_case_119:
+//line parser_rl.go:5605
+		if data[p] == 58 {
+			goto st249
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st120
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st120
+			}
+		default:
+			goto st120
+		}
+		goto st0
+	st120:
+		if p++; p == pe {
+			goto _test_eof120
+		}
+	st_case_120:
+		if data[p] == 58 {
+			goto st124
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st121
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st121
+			}
+		default:
+			goto st121
+		}
+		goto st0
+	st121:
+		if p++; p == pe {
+			goto _test_eof121
+		}
+	st_case_121:
+		if data[p] == 58 {
+			goto st124
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st122
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st122
+			}
+		default:
+			goto st122
+		}
+		goto st0
+	st122:
+		if p++; p == pe {
+			goto _test_eof122
+		}
+	st_case_122:
+		if data[p] == 58 {
+			goto st124
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st123
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st123
+			}
+		default:
+			goto st123
+		}
+		goto st0
+	st123:
+		if p++; p == pe {
+			goto _test_eof123
+		}
+	st_case_123:
+		if data[p] == 58 {
+			goto st124
+		}
+		goto st0
+	st124:
+		if p++; p == pe {
+			goto _test_eof124
+		}
+	st_case_124:
+		if data[p] == 58 {
+			goto st236
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st125
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st125
+			}
+		default:
+			goto st125
+		}
+		goto st0
+	st125:
+		if p++; p == pe {
+			goto _test_eof125
+		}
+	st_case_125:
+		if data[p] == 58 {
+			goto st129
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st126
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st126
+			}
+		default:
+			goto st126
+		}
+		goto st0
+	st126:
+		if p++; p == pe {
+			goto _test_eof126
+		}
+	st_case_126:
+		if data[p] == 58 {
+			goto st129
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st127
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st127
+			}
+		default:
+			goto st127
+		}
+		goto st0
+	st127:
+		if p++; p == pe {
+			goto _test_eof127
+		}
+	st_case_127:
+		if data[p] == 58 {
+			goto st129
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st128
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st128
+			}
+		default:
+			goto st128
+		}
+		goto st0
+	st128:
+		if p++; p == pe {
+			goto _test_eof128
+		}
+	st_case_128:
+		if data[p] == 58 {
+			goto st129
+		}
+		goto st0
+	st129:
+		if p++; p == pe {
+			goto _test_eof129
+		}
+	st_case_129:
+		if data[p] == 58 {
+			goto st223
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st130
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st130
+			}
+		default:
+			goto st130
+		}
+		goto st0
+	st130:
+		if p++; p == pe {
+			goto _test_eof130
+		}
+	st_case_130:
+		if data[p] == 58 {
+			goto st134
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st131
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st131
+			}
+		default:
+			goto st131
+		}
+		goto st0
+	st131:
+		if p++; p == pe {
+			goto _test_eof131
+		}
+	st_case_131:
+		if data[p] == 58 {
+			goto st134
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st132
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st132
+			}
+		default:
+			goto st132
+		}
+		goto st0
+	st132:
+		if p++; p == pe {
+			goto _test_eof132
+		}
+	st_case_132:
+		if data[p] == 58 {
+			goto st134
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st133
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st133
+			}
+		default:
+			goto st133
+		}
+		goto st0
+	st133:
+		if p++; p == pe {
+			goto _test_eof133
+		}
+	st_case_133:
+		if data[p] == 58 {
+			goto st134
+		}
+		goto st0
+	st134:
+		if p++; p == pe {
+			goto _test_eof134
+		}
+	st_case_134:
+		if data[p] == 58 {
+			goto st210
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st135
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st135
+			}
+		default:
+			goto st135
+		}
+		goto st0
+	st135:
+		if p++; p == pe {
+			goto _test_eof135
+		}
+	st_case_135:
+		if data[p] == 58 {
+			goto st139
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st136
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st136
+			}
+		default:
+			goto st136
+		}
+		goto st0
+	st136:
+		if p++; p == pe {
+			goto _test_eof136
+		}
+	st_case_136:
+		if data[p] == 58 {
+			goto st139
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st137
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st137
+			}
+		default:
+			goto st137
+		}
+		goto st0
+	st137:
+		if p++; p == pe {
+			goto _test_eof137
+		}
+	st_case_137:
+		if data[p] == 58 {
+			goto st139
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st138
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st138
+			}
+		default:
+			goto st138
+		}
+		goto st0
+	st138:
+		if p++; p == pe {
+			goto _test_eof138
+		}
+	st_case_138:
+		if data[p] == 58 {
+			goto st139
+		}
+		goto st0
+	st139:
+		if p++; p == pe {
+			goto _test_eof139
+		}
+	st_case_139:
+		if data[p] == 58 {
+			goto st197
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st140
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st140
+			}
+		default:
+			goto st140
+		}
+		goto st0
+	st140:
+		if p++; p == pe {
+			goto _test_eof140
+		}
+	st_case_140:
+		if data[p] == 58 {
+			goto st144
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st141
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st141
+			}
+		default:
+			goto st141
+		}
+		goto st0
+	st141:
+		if p++; p == pe {
+			goto _test_eof141
+		}
+	st_case_141:
+		if data[p] == 58 {
+			goto st144
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st142
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st142
+			}
+		default:
+			goto st142
+		}
+		goto st0
+	st142:
+		if p++; p == pe {
+			goto _test_eof142
+		}
+	st_case_142:
+		if data[p] == 58 {
+			goto st144
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st143
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st143
+			}
+		default:
+			goto st143
+		}
+		goto st0
+	st143:
+		if p++; p == pe {
+			goto _test_eof143
+		}
+	st_case_143:
+		if data[p] == 58 {
+			goto st144
+		}
+		goto st0
+	st144:
+		if p++; p == pe {
+			goto _test_eof144
+		}
+	st_case_144:
+		if data[p] == 58 {
+			goto st184
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st145
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st145
+			}
+		default:
+			goto st145
+		}
+		goto st0
+	st145:
+		if p++; p == pe {
+			goto _test_eof145
+		}
+	st_case_145:
+		if data[p] == 58 {
+			goto st149
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st146
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st146
+			}
+		default:
+			goto st146
+		}
+		goto st0
+	st146:
+		if p++; p == pe {
+			goto _test_eof146
+		}
+	st_case_146:
+		if data[p] == 58 {
+			goto st149
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st147
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st147
+			}
+		default:
+			goto st147
+		}
+		goto st0
+	st147:
+		if p++; p == pe {
+			goto _test_eof147
+		}
+	st_case_147:
+		if data[p] == 58 {
+			goto st149
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st148
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st148
+			}
+		default:
+			goto st148
+		}
+		goto st0
+	st148:
+		if p++; p == pe {
+			goto _test_eof148
+		}
+	st_case_148:
+		if data[p] == 58 {
+			goto st149
+		}
+		goto st0
+	st149:
+		if p++; p == pe {
+			goto _test_eof149
+		}
+	st_case_149:
+		switch data[p] {
+		case 50:
+			goto st178
+		case 58:
+			goto st182
+		}
+		switch {
+		case data[p] < 51:
+			if 48 <= data[p] && data[p] <= 49 {
+				goto st150
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st183
+				}
+			case data[p] >= 65:
+				goto st183
+			}
+		default:
+			goto st181
+		}
+		goto st0
+	st150:
+		if p++; p == pe {
+			goto _test_eof150
+		}
+	st_case_150:
+		switch data[p] {
+		case 46:
+			goto st151
+		case 58:
+			goto st172
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st169
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st177
+			}
+		default:
+			goto st177
+		}
+		goto st0
+	st151:
+		if p++; p == pe {
+			goto _test_eof151
+		}
+	st_case_151:
+		if data[p] == 50 {
+			goto st167
+		}
+		switch {
+		case data[p] > 49:
+			if 51 <= data[p] && data[p] <= 57 {
+				goto st165
+			}
+		case data[p] >= 48:
+			goto st152
+		}
+		goto st0
+	st152:
+		if p++; p == pe {
+			goto _test_eof152
+		}
+	st_case_152:
+		if data[p] == 46 {
+			goto st153
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st165
+		}
+		goto st0
+	st153:
+		if p++; p == pe {
+			goto _test_eof153
+		}
+	st_case_153:
+		if data[p] == 50 {
+			goto st163
+		}
+		switch {
+		case data[p] > 49:
+			if 51 <= data[p] && data[p] <= 57 {
+				goto st161
+			}
+		case data[p] >= 48:
+			goto st154
+		}
+		goto st0
+	st154:
+		if p++; p == pe {
+			goto _test_eof154
+		}
+	st_case_154:
+		if data[p] == 46 {
+			goto st155
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st161
+		}
+		goto st0
+	st155:
+		if p++; p == pe {
+			goto _test_eof155
+		}
+	st_case_155:
+		if data[p] == 50 {
+			goto st159
+		}
+		switch {
+		case data[p] > 49:
+			if 51 <= data[p] && data[p] <= 57 {
+				goto st157
+			}
+		case data[p] >= 48:
+			goto st156
+		}
+		goto st0
+	st156:
+		if p++; p == pe {
+			goto _test_eof156
+		}
+	st_case_156:
+		if data[p] == 93 {
+			goto st303
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st157
+		}
+		goto st0
+	st157:
+		if p++; p == pe {
+			goto _test_eof157
+		}
+	st_case_157:
+		if data[p] == 93 {
+			goto st303
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st158
+		}
+		goto st0
+	st158:
+		if p++; p == pe {
+			goto _test_eof158
+		}
+	st_case_158:
+		if data[p] == 93 {
+			goto st303
+		}
+		goto st0
+	st303:
+		if p++; p == pe {
+			goto _test_eof303
+		}
+	st_case_303:
+		switch data[p] {
+		case 58:
+			goto st88
+		case 59:
+			goto tr312
+		case 63:
+			goto tr313
+		}
+		goto st0
+	st159:
+		if p++; p == pe {
+			goto _test_eof159
+		}
+	st_case_159:
+		switch data[p] {
+		case 53:
+			goto st160
+		case 93:
+			goto st303
+		}
+		switch {
+		case data[p] > 52:
+			if 54 <= data[p] && data[p] <= 57 {
+				goto st158
+			}
+		case data[p] >= 48:
+			goto st157
+		}
+		goto st0
+	st160:
+		if p++; p == pe {
+			goto _test_eof160
+		}
+	st_case_160:
+		if data[p] == 93 {
+			goto st303
+		}
+		if 48 <= data[p] && data[p] <= 53 {
+			goto st158
+		}
+		goto st0
+	st161:
+		if p++; p == pe {
+			goto _test_eof161
+		}
+	st_case_161:
+		if data[p] == 46 {
+			goto st155
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st162
+		}
+		goto st0
+	st162:
+		if p++; p == pe {
+			goto _test_eof162
+		}
+	st_case_162:
+		if data[p] == 46 {
+			goto st155
+		}
+		goto st0
+	st163:
+		if p++; p == pe {
+			goto _test_eof163
+		}
+	st_case_163:
+		switch data[p] {
+		case 46:
+			goto st155
+		case 53:
+			goto st164
+		}
+		switch {
+		case data[p] > 52:
+			if 54 <= data[p] && data[p] <= 57 {
+				goto st162
+			}
+		case data[p] >= 48:
+			goto st161
+		}
+		goto st0
+	st164:
+		if p++; p == pe {
+			goto _test_eof164
+		}
+	st_case_164:
+		if data[p] == 46 {
+			goto st155
+		}
+		if 48 <= data[p] && data[p] <= 53 {
+			goto st162
+		}
+		goto st0
+	st165:
+		if p++; p == pe {
+			goto _test_eof165
+		}
+	st_case_165:
+		if data[p] == 46 {
+			goto st153
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st166
+		}
+		goto st0
+	st166:
+		if p++; p == pe {
+			goto _test_eof166
+		}
+	st_case_166:
+		if data[p] == 46 {
+			goto st153
+		}
+		goto st0
+	st167:
+		if p++; p == pe {
+			goto _test_eof167
+		}
+	st_case_167:
+		switch data[p] {
+		case 46:
+			goto st153
+		case 53:
+			goto st168
+		}
+		switch {
+		case data[p] > 52:
+			if 54 <= data[p] && data[p] <= 57 {
+				goto st166
+			}
+		case data[p] >= 48:
+			goto st165
+		}
+		goto st0
+	st168:
+		if p++; p == pe {
+			goto _test_eof168
+		}
+	st_case_168:
+		if data[p] == 46 {
+			goto st153
+		}
+		if 48 <= data[p] && data[p] <= 53 {
+			goto st166
+		}
+		goto st0
+	st169:
+		if p++; p == pe {
+			goto _test_eof169
+		}
+	st_case_169:
+		switch data[p] {
+		case 46:
+			goto st151
+		case 58:
+			goto st172
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st170
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st176
+			}
+		default:
+			goto st176
+		}
+		goto st0
+	st170:
+		if p++; p == pe {
+			goto _test_eof170
+		}
+	st_case_170:
+		switch data[p] {
+		case 46:
+			goto st151
+		case 58:
+			goto st172
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st171
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st171
+			}
+		default:
+			goto st171
+		}
+		goto st0
+	st171:
+		if p++; p == pe {
+			goto _test_eof171
+		}
+	st_case_171:
+		if data[p] == 58 {
+			goto st172
+		}
+		goto st0
+	st172:
+		if p++; p == pe {
+			goto _test_eof172
+		}
+	st_case_172:
+		if data[p] == 58 {
+			goto st158
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st173
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st173
+			}
+		default:
+			goto st173
+		}
+		goto st0
+	st173:
+		if p++; p == pe {
+			goto _test_eof173
+		}
+	st_case_173:
+		if data[p] == 93 {
+			goto st303
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st174
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st174
+			}
+		default:
+			goto st174
+		}
+		goto st0
+	st174:
+		if p++; p == pe {
+			goto _test_eof174
+		}
+	st_case_174:
+		if data[p] == 93 {
+			goto st303
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st175
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st175
+			}
+		default:
+			goto st175
+		}
+		goto st0
+	st175:
+		if p++; p == pe {
+			goto _test_eof175
+		}
+	st_case_175:
+		if data[p] == 93 {
+			goto st303
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st158
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st158
+			}
+		default:
+			goto st158
+		}
+		goto st0
+	st176:
+		if p++; p == pe {
+			goto _test_eof176
+		}
+	st_case_176:
+		if data[p] == 58 {
+			goto st172
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st171
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st171
+			}
+		default:
+			goto st171
+		}
+		goto st0
+	st177:
+		if p++; p == pe {
+			goto _test_eof177
+		}
+	st_case_177:
+		if data[p] == 58 {
+			goto st172
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st176
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st176
+			}
+		default:
+			goto st176
+		}
+		goto st0
+	st178:
+		if p++; p == pe {
+			goto _test_eof178
+		}
+	st_case_178:
+		switch data[p] {
+		case 46:
+			goto st151
+		case 53:
+			goto st179
+		case 58:
+			goto st172
+		}
+		switch {
+		case data[p] < 54:
+			if 48 <= data[p] && data[p] <= 52 {
+				goto st169
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st177
+				}
+			case data[p] >= 65:
+				goto st177
+			}
+		default:
+			goto st180
+		}
+		goto st0
+	st179:
+		if p++; p == pe {
+			goto _test_eof179
+		}
+	st_case_179:
+		switch data[p] {
+		case 46:
+			goto st151
+		case 58:
+			goto st172
+		}
+		switch {
+		case data[p] < 54:
+			if 48 <= data[p] && data[p] <= 53 {
+				goto st170
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st176
+				}
+			case data[p] >= 65:
+				goto st176
+			}
+		default:
+			goto st176
+		}
+		goto st0
+	st180:
+		if p++; p == pe {
+			goto _test_eof180
+		}
+	st_case_180:
+		switch data[p] {
+		case 46:
+			goto st151
+		case 58:
+			goto st172
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st176
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st176
+			}
+		default:
+			goto st176
+		}
+		goto st0
+	st181:
+		if p++; p == pe {
+			goto _test_eof181
+		}
+	st_case_181:
+		switch data[p] {
+		case 46:
+			goto st151
+		case 58:
+			goto st172
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st180
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st177
+			}
+		default:
+			goto st177
+		}
+		goto st0
+	st182:
+		if p++; p == pe {
+			goto _test_eof182
+		}
+	st_case_182:
+		if data[p] == 93 {
+			goto st303
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st173
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st173
+			}
+		default:
+			goto st173
+		}
+		goto st0
+	st183:
+		if p++; p == pe {
+			goto _test_eof183
+		}
+	st_case_183:
+		if data[p] == 58 {
+			goto st172
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st177
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st177
+			}
+		default:
+			goto st177
+		}
+		goto st0
+	st184:
+		if p++; p == pe {
+			goto _test_eof184
+		}
+	st_case_184:
+		switch data[p] {
+		case 50:
+			goto st192
+		case 93:
+			goto st303
+		}
+		switch {
+		case data[p] < 51:
+			if 48 <= data[p] && data[p] <= 49 {
+				goto st185
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st196
+				}
+			case data[p] >= 65:
+				goto st196
+			}
+		default:
+			goto st195
+		}
+		goto st0
+	st185:
+		if p++; p == pe {
+			goto _test_eof185
+		}
+	st_case_185:
+		switch data[p] {
+		case 46:
+			goto st151
+		case 58:
+			goto st189
+		case 93:
+			goto st303
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st186
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st191
+			}
+		default:
+			goto st191
+		}
+		goto st0
+	st186:
+		if p++; p == pe {
+			goto _test_eof186
+		}
+	st_case_186:
+		switch data[p] {
+		case 46:
+			goto st151
+		case 58:
+			goto st189
+		case 93:
+			goto st303
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st187
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st190
+			}
+		default:
+			goto st190
+		}
+		goto st0
+	st187:
+		if p++; p == pe {
+			goto _test_eof187
+		}
+	st_case_187:
+		switch data[p] {
+		case 46:
+			goto st151
+		case 58:
+			goto st189
+		case 93:
+			goto st303
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st188
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st188
+			}
+		default:
+			goto st188
+		}
+		goto st0
+	st188:
+		if p++; p == pe {
+			goto _test_eof188
+		}
+	st_case_188:
+		switch data[p] {
+		case 58:
+			goto st189
+		case 93:
+			goto st303
+		}
+		goto st0
+	st189:
+		if p++; p == pe {
+			goto _test_eof189
+		}
+	st_case_189:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st173
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st173
+			}
+		default:
+			goto st173
+		}
+		goto st0
+	st190:
+		if p++; p == pe {
+			goto _test_eof190
+		}
+	st_case_190:
+		switch data[p] {
+		case 58:
+			goto st189
+		case 93:
+			goto st303
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st188
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st188
+			}
+		default:
+			goto st188
+		}
+		goto st0
+	st191:
+		if p++; p == pe {
+			goto _test_eof191
+		}
+	st_case_191:
+		switch data[p] {
+		case 58:
+			goto st189
+		case 93:
+			goto st303
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st190
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st190
+			}
+		default:
+			goto st190
+		}
+		goto st0
+	st192:
+		if p++; p == pe {
+			goto _test_eof192
+		}
+	st_case_192:
+		switch data[p] {
+		case 46:
+			goto st151
+		case 53:
+			goto st193
+		case 58:
+			goto st189
+		case 93:
+			goto st303
+		}
+		switch {
+		case data[p] < 54:
+			if 48 <= data[p] && data[p] <= 52 {
+				goto st186
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st191
+				}
+			case data[p] >= 65:
+				goto st191
+			}
+		default:
+			goto st194
+		}
+		goto st0
+	st193:
+		if p++; p == pe {
+			goto _test_eof193
+		}
+	st_case_193:
+		switch data[p] {
+		case 46:
+			goto st151
+		case 58:
+			goto st189
+		case 93:
+			goto st303
 		}
 		switch {
-		case data[p] < 48:
-			if 36 <= data[p] && data[p] <= 47 {
-				goto st6
+		case data[p] < 54:
+			if 48 <= data[p] && data[p] <= 53 {
+				goto st187
 			}
 		case data[p] > 57:
 			switch {
-			case data[p] > 90:
-				if 97 <= data[p] && data[p] <= 122 {
-					goto st134
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st190
 				}
 			case data[p] >= 65:
-				goto st134
+				goto st190
 			}
 		default:
-			goto st134
+			goto st190
 		}
 		goto st0
-	st82:
+	st194:
 		if p++; p == pe {
-			goto _test_eof82
+			goto _test_eof194
 		}
-	st_case_82:
+	st_case_194:
 		switch data[p] {
-		case 33:
-			goto st6
-		case 37:
-			goto st7
-		case 45:
-			goto st82
+		case 46:
+			goto st151
 		case 58:
-			goto st9
-		case 59:
-			goto st6
-		case 61:
-			goto st6
-		case 63:
-			goto st6
-		case 64:
-			goto tr14
-		case 95:
-			goto st6
-		case 126:
-			goto st6
+			goto st189
+		case 93:
+			goto st303
 		}
 		switch {
-		case data[p] < 48:
-			if 36 <= data[p] && data[p] <= 47 {
-				goto st6
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st190
 			}
-		case data[p] > 57:
-			switch {
-			case data[p] > 90:
-				if 97 <= data[p] && data[p] <= 122 {
-					goto st134
-				}
-			case data[p] >= 65:
-				goto st134
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st190
 			}
 		default:
-			goto st134
+			goto st190
 		}
 		goto st0
-	st135:
+	st195:
 		if p++; p == pe {
-			goto _test_eof135
+			goto _test_eof195
 		}
-	st_case_135:
+	st_case_195:
 		switch data[p] {
-		case 33:
-			goto st6
-		case 37:
-			goto st7
+		case 46:
+			goto st151
 		case 58:
-			goto st83
-		case 59:
-			goto tr146
-		case 61:
-			goto st6
-		case 63:
-			goto tr147
-		case 64:
-			goto tr14
-		case 95:
-			goto st6
-		case 126:
-			goto st6
+			goto st189
+		case 93:
+			goto st303
 		}
 		switch {
-		case data[p] < 48:
-			if 36 <= data[p] && data[p] <= 47 {
-				goto st6
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st194
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st191
+			}
+		default:
+			goto st191
+		}
+		goto st0
+	st196:
+		if p++; p == pe {
+			goto _test_eof196
+		}
+	st_case_196:
+		switch data[p] {
+		case 58:
+			goto st189
+		case 93:
+			goto st303
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st191
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st191
+			}
+		default:
+			goto st191
+		}
+		goto st0
+	st197:
+		if p++; p == pe {
+			goto _test_eof197
+		}
+	st_case_197:
+		switch data[p] {
+		case 50:
+			goto st205
+		case 93:
+			goto st303
+		}
+		switch {
+		case data[p] < 51:
+			if 48 <= data[p] && data[p] <= 49 {
+				goto st198
 			}
 		case data[p] > 57:
 			switch {
-			case data[p] > 90:
-				if 97 <= data[p] && data[p] <= 122 {
-					goto st134
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st209
 				}
 			case data[p] >= 65:
-				goto st134
+				goto st209
 			}
 		default:
-			goto st80
+			goto st208
 		}
 		goto st0
-	st83:
+	st198:
 		if p++; p == pe {
-			goto _test_eof83
+			goto _test_eof198
 		}
-	st_case_83:
+	st_case_198:
 		switch data[p] {
-		case 33:
-			goto st9
-		case 37:
-			goto st10
-		case 61:
-			goto st9
-		case 64:
-			goto tr14
-		case 95:
-			goto st9
-		case 126:
-			goto st9
+		case 46:
+			goto st151
+		case 58:
+			goto st202
+		case 93:
+			goto st303
 		}
 		switch {
-		case data[p] < 48:
-			if 36 <= data[p] && data[p] <= 46 {
-				goto st9
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st199
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st204
+			}
+		default:
+			goto st204
+		}
+		goto st0
+	st199:
+		if p++; p == pe {
+			goto _test_eof199
+		}
+	st_case_199:
+		switch data[p] {
+		case 46:
+			goto st151
+		case 58:
+			goto st202
+		case 93:
+			goto st303
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st200
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st203
+			}
+		default:
+			goto st203
+		}
+		goto st0
+	st200:
+		if p++; p == pe {
+			goto _test_eof200
+		}
+	st_case_200:
+		switch data[p] {
+		case 46:
+			goto st151
+		case 58:
+			goto st202
+		case 93:
+			goto st303
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st201
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st201
+			}
+		default:
+			goto st201
+		}
+		goto st0
+	st201:
+		if p++; p == pe {
+			goto _test_eof201
+		}
+	st_case_201:
+		switch data[p] {
+		case 58:
+			goto st202
+		case 93:
+			goto st303
+		}
+		goto st0
+	st202:
+		if p++; p == pe {
+			goto _test_eof202
+		}
+	st_case_202:
+		if data[p] == 50 {
+			goto st192
+		}
+		switch {
+		case data[p] < 51:
+			if 48 <= data[p] && data[p] <= 49 {
+				goto st185
 			}
 		case data[p] > 57:
 			switch {
-			case data[p] > 90:
-				if 97 <= data[p] && data[p] <= 122 {
-					goto st9
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st196
 				}
 			case data[p] >= 65:
-				goto st9
+				goto st196
 			}
 		default:
-			goto st136
+			goto st195
 		}
 		goto st0
-	st136:
+	st203:
 		if p++; p == pe {
-			goto _test_eof136
+			goto _test_eof203
 		}
-	st_case_136:
+	st_case_203:
 		switch data[p] {
-		case 33:
-			goto st9
-		case 37:
-			goto st10
-		case 59:
-			goto tr131
-		case 61:
-			goto st9
-		case 63:
-			goto tr132
-		case 64:
-			goto tr14
-		case 95:
-			goto st9
-		case 126:
-			goto st9
+		case 58:
+			goto st202
+		case 93:
+			goto st303
 		}
 		switch {
-		case data[p] < 48:
-			if 36 <= data[p] && data[p] <= 46 {
-				goto st9
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st201
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st201
+			}
+		default:
+			goto st201
+		}
+		goto st0
+	st204:
+		if p++; p == pe {
+			goto _test_eof204
+		}
+	st_case_204:
+		switch data[p] {
+		case 58:
+			goto st202
+		case 93:
+			goto st303
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st203
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st203
+			}
+		default:
+			goto st203
+		}
+		goto st0
+	st205:
+		if p++; p == pe {
+			goto _test_eof205
+		}
+	st_case_205:
+		switch data[p] {
+		case 46:
+			goto st151
+		case 53:
+			goto st206
+		case 58:
+			goto st202
+		case 93:
+			goto st303
+		}
+		switch {
+		case data[p] < 54:
+			if 48 <= data[p] && data[p] <= 52 {
+				goto st199
 			}
 		case data[p] > 57:
 			switch {
-			case data[p] > 90:
-				if 97 <= data[p] && data[p] <= 122 {
-					goto st9
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st204
 				}
 			case data[p] >= 65:
-				goto st9
+				goto st204
 			}
 		default:
-			goto st137
+			goto st207
 		}
 		goto st0
-	st137:
+	st206:
 		if p++; p == pe {
-			goto _test_eof137
+			goto _test_eof206
 		}
-	st_case_137:
+	st_case_206:
 		switch data[p] {
-		case 33:
-			goto st9
-		case 37:
-			goto st10
-		case 59:
-			goto tr131
-		case 61:
-			goto st9
-		case 63:
-			goto tr132
-		case 64:
-			goto tr14
-		case 95:
-			goto st9
-		case 126:
-			goto st9
+		case 46:
+			goto st151
+		case 58:
+			goto st202
+		case 93:
+			goto st303
 		}
 		switch {
-		case data[p] < 48:
-			if 36 <= data[p] && data[p] <= 46 {
-				goto st9
+		case data[p] < 54:
+			if 48 <= data[p] && data[p] <= 53 {
+				goto st200
 			}
 		case data[p] > 57:
 			switch {
-			case data[p] > 90:
-				if 97 <= data[p] && data[p] <= 122 {
-					goto st9
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st203
 				}
 			case data[p] >= 65:
-				goto st9
+				goto st203
+			}
+		default:
+			goto st203
+		}
+		goto st0
+	st207:
+		if p++; p == pe {
+			goto _test_eof207
+		}
+	st_case_207:
+		switch data[p] {
+		case 46:
+			goto st151
+		case 58:
+			goto st202
+		case 93:
+			goto st303
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st203
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st203
 			}
 		default:
-			goto st138
+			goto st203
 		}
 		goto st0
-	st138:
+	st208:
 		if p++; p == pe {
-			goto _test_eof138
+			goto _test_eof208
 		}
-	st_case_138:
+	st_case_208:
 		switch data[p] {
-		case 33:
-			goto st9
-		case 37:
-			goto st10
-		case 59:
-			goto tr131
-		case 61:
-			goto st9
-		case 63:
-			goto tr132
-		case 64:
-			goto tr14
-		case 95:
-			goto st9
-		case 126:
-			goto st9
+		case 46:
+			goto st151
+		case 58:
+			goto st202
+		case 93:
+			goto st303
 		}
 		switch {
-		case data[p] < 48:
-			if 36 <= data[p] && data[p] <= 46 {
-				goto st9
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st207
 			}
-		case data[p] > 57:
-			switch {
-			case data[p] > 90:
-				if 97 <= data[p] && data[p] <= 122 {
-					goto st9
-				}
-			case data[p] >= 65:
-				goto st9
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st204
 			}
 		default:
-			goto st139
+			goto st204
 		}
 		goto st0
-	st139:
+	st209:
 		if p++; p == pe {
-			goto _test_eof139
+			goto _test_eof209
 		}
-	st_case_139:
+	st_case_209:
 		switch data[p] {
-		case 33:
-			goto st9
-		case 37:
-			goto st10
-		case 59:
-			goto tr131
-		case 61:
-			goto st9
-		case 63:
-			goto tr132
-		case 64:
-			goto tr14
-		case 95:
-			goto st9
-		case 126:
-			goto st9
+		case 58:
+			goto st202
+		case 93:
+			goto st303
 		}
 		switch {
-		case data[p] < 48:
-			if 36 <= data[p] && data[p] <= 46 {
-				goto st9
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st204
 			}
-		case data[p] > 57:
-			switch {
-			case data[p] > 90:
-				if 97 <= data[p] && data[p] <= 122 {
-					goto st9
-				}
-			case data[p] >= 65:
-				goto st9
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st204
 			}
 		default:
-			goto st140
+			goto st204
 		}
 		goto st0
-	st140:
+	st210:
 		if p++; p == pe {
-			goto _test_eof140
+			goto _test_eof210
 		}
-	st_case_140:
+	st_case_210:
 		switch data[p] {
-		case 33:
-			goto st9
-		case 37:
-			goto st10
-		case 59:
-			goto tr131
-		case 61:
-			goto st9
-		case 63:
-			goto tr132
-		case 64:
-			goto tr14
-		case 95:
-			goto st9
-		case 126:
-			goto st9
+		case 50:
+			goto st218
+		case 93:
+			goto st303
 		}
 		switch {
-		case data[p] < 48:
-			if 36 <= data[p] && data[p] <= 46 {
-				goto st9
+		case data[p] < 51:
+			if 48 <= data[p] && data[p] <= 49 {
+				goto st211
 			}
 		case data[p] > 57:
 			switch {
-			case data[p] > 90:
-				if 97 <= data[p] && data[p] <= 122 {
-					goto st9
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st222
 				}
 			case data[p] >= 65:
-				goto st9
+				goto st222
 			}
 		default:
-			goto st9
+			goto st221
 		}
 		goto st0
-tr146:
-//line parser.rl:24
- uri.hostport = str[m:p] 
-//line parser.rl:20
- m = p 
-	goto st84
-	st84:
+	st211:
 		if p++; p == pe {
-			goto _test_eof84
+			goto _test_eof211
 		}
-	st_case_84:
-//line parser_rl.go:2876
+	st_case_211:
 		switch data[p] {
-		case 33:
-			goto st141
-		case 37:
-			goto st85
-		case 44:
-			goto st6
+		case 46:
+			goto st151
 		case 58:
-			goto st142
-		case 59:
-			goto st6
-		case 61:
-			goto st6
-		case 63:
-			goto st6
-		case 64:
-			goto tr14
-		case 91:
-			goto st127
+			goto st215
 		case 93:
-			goto st127
-		case 95:
-			goto st141
-		case 126:
-			goto st141
+			goto st303
 		}
 		switch {
 		case data[p] < 65:
-			if 36 <= data[p] && data[p] <= 57 {
-				goto st141
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st212
 			}
-		case data[p] > 90:
-			if 97 <= data[p] && data[p] <= 122 {
-				goto st141
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st217
 			}
 		default:
-			goto st141
+			goto st217
 		}
 		goto st0
-	st141:
+	st212:
 		if p++; p == pe {
-			goto _test_eof141
+			goto _test_eof212
 		}
-	st_case_141:
+	st_case_212:
 		switch data[p] {
-		case 33:
-			goto st141
-		case 37:
-			goto st85
-		case 44:
-			goto st6
+		case 46:
+			goto st151
 		case 58:
-			goto st142
-		case 59:
-			goto st84
-		case 61:
-			goto st92
-		case 63:
-			goto tr154
-		case 64:
-			goto tr14
-		case 91:
-			goto st127
+			goto st215
 		case 93:
-			goto st127
-		case 95:
-			goto st141
-		case 126:
-			goto st141
+			goto st303
 		}
 		switch {
 		case data[p] < 65:
-			if 36 <= data[p] && data[p] <= 57 {
-				goto st141
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st213
 			}
-		case data[p] > 90:
-			if 97 <= data[p] && data[p] <= 122 {
-				goto st141
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st216
 			}
 		default:
-			goto st141
+			goto st216
 		}
 		goto st0
-	st85:
+	st213:
 		if p++; p == pe {
-			goto _test_eof85
+			goto _test_eof213
+		}
+	st_case_213:
+		switch data[p] {
+		case 46:
+			goto st151
+		case 58:
+			goto st215
+		case 93:
+			goto st303
 		}
-	st_case_85:
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st86
+				goto st214
 			}
 		case data[p] > 70:
 			if 97 <= data[p] && data[p] <= 102 {
-				goto st86
+				goto st214
 			}
 		default:
-			goto st86
+			goto st214
 		}
 		goto st0
-	st86:
+	st214:
 		if p++; p == pe {
-			goto _test_eof86
+			goto _test_eof214
+		}
+	st_case_214:
+		switch data[p] {
+		case 58:
+			goto st215
+		case 93:
+			goto st303
+		}
+		goto st0
+	st215:
+		if p++; p == pe {
+			goto _test_eof215
+		}
+	st_case_215:
+		if data[p] == 50 {
+			goto st205
 		}
-	st_case_86:
 		switch {
-		case data[p] < 65:
-			if 48 <= data[p] && data[p] <= 57 {
-				goto st141
+		case data[p] < 51:
+			if 48 <= data[p] && data[p] <= 49 {
+				goto st198
 			}
-		case data[p] > 70:
-			if 97 <= data[p] && data[p] <= 102 {
-				goto st141
+		case data[p] > 57:
+			switch {
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st209
+				}
+			case data[p] >= 65:
+				goto st209
 			}
 		default:
-			goto st141
+			goto st208
 		}
 		goto st0
-	st142:
+	st216:
 		if p++; p == pe {
-			goto _test_eof142
+			goto _test_eof216
 		}
-	st_case_142:
+	st_case_216:
 		switch data[p] {
-		case 33:
-			goto st142
-		case 37:
-			goto st87
-		case 44:
-			goto st9
-		case 47:
-			goto st127
 		case 58:
-			goto st127
-		case 59:
-			goto st19
-		case 61:
-			goto st89
-		case 63:
-			goto tr139
-		case 64:
-			goto tr14
-		case 91:
-			goto st127
+			goto st215
 		case 93:
-			goto st127
-		case 95:
-			goto st142
-		case 126:
-			goto st142
+			goto st303
 		}
 		switch {
 		case data[p] < 65:
-			if 36 <= data[p] && data[p] <= 57 {
-				goto st142
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st214
 			}
-		case data[p] > 90:
-			if 97 <= data[p] && data[p] <= 122 {
-				goto st142
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st214
 			}
 		default:
-			goto st142
+			goto st214
 		}
 		goto st0
-	st87:
+	st217:
 		if p++; p == pe {
-			goto _test_eof87
+			goto _test_eof217
+		}
+	st_case_217:
+		switch data[p] {
+		case 58:
+			goto st215
+		case 93:
+			goto st303
 		}
-	st_case_87:
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st88
+				goto st216
 			}
 		case data[p] > 70:
 			if 97 <= data[p] && data[p] <= 102 {
-				goto st88
+				goto st216
 			}
 		default:
-			goto st88
+			goto st216
 		}
 		goto st0
-	st88:
+	st218:
 		if p++; p == pe {
-			goto _test_eof88
+			goto _test_eof218
+		}
+	st_case_218:
+		switch data[p] {
+		case 46:
+			goto st151
+		case 53:
+			goto st219
+		case 58:
+			goto st215
+		case 93:
+			goto st303
 		}
-	st_case_88:
 		switch {
-		case data[p] < 65:
-			if 48 <= data[p] && data[p] <= 57 {
-				goto st142
+		case data[p] < 54:
+			if 48 <= data[p] && data[p] <= 52 {
+				goto st212
 			}
-		case data[p] > 70:
-			if 97 <= data[p] && data[p] <= 102 {
-				goto st142
+		case data[p] > 57:
+			switch {
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st217
+				}
+			case data[p] >= 65:
+				goto st217
 			}
 		default:
-			goto st142
+			goto st220
 		}
 		goto st0
-	st89:
+	st219:
 		if p++; p == pe {
-			goto _test_eof89
+			goto _test_eof219
 		}
-	st_case_89:
+	st_case_219:
 		switch data[p] {
-		case 33:
-			goto st143
-		case 37:
-			goto st90
-		case 44:
-			goto st9
-		case 47:
-			goto st128
+		case 46:
+			goto st151
 		case 58:
-			goto st128
-		case 61:
-			goto st9
-		case 64:
-			goto tr14
-		case 91:
-			goto st128
+			goto st215
 		case 93:
-			goto st128
-		case 95:
-			goto st143
-		case 126:
-			goto st143
+			goto st303
 		}
 		switch {
-		case data[p] < 65:
-			if 36 <= data[p] && data[p] <= 57 {
-				goto st143
+		case data[p] < 54:
+			if 48 <= data[p] && data[p] <= 53 {
+				goto st213
 			}
-		case data[p] > 90:
-			if 97 <= data[p] && data[p] <= 122 {
-				goto st143
+		case data[p] > 57:
+			switch {
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st216
+				}
+			case data[p] >= 65:
+				goto st216
 			}
 		default:
-			goto st143
+			goto st216
 		}
 		goto st0
-	st143:
+	st220:
 		if p++; p == pe {
-			goto _test_eof143
+			goto _test_eof220
 		}
-	st_case_143:
+	st_case_220:
 		switch data[p] {
-		case 33:
-			goto st143
-		case 37:
-			goto st90
-		case 44:
-			goto st9
-		case 47:
-			goto st128
+		case 46:
+			goto st151
 		case 58:
-			goto st128
-		case 59:
-			goto st19
-		case 61:
-			goto st9
-		case 63:
-			goto tr139
-		case 64:
-			goto tr14
-		case 91:
-			goto st128
+			goto st215
 		case 93:
-			goto st128
-		case 95:
-			goto st143
-		case 126:
-			goto st143
+			goto st303
 		}
 		switch {
 		case data[p] < 65:
-			if 36 <= data[p] && data[p] <= 57 {
-				goto st143
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st216
 			}
-		case data[p] > 90:
-			if 97 <= data[p] && data[p] <= 122 {
-				goto st143
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st216
 			}
 		default:
-			goto st143
+			goto st216
 		}
 		goto st0
-	st90:
+	st221:
 		if p++; p == pe {
-			goto _test_eof90
+			goto _test_eof221
+		}
+	st_case_221:
+		switch data[p] {
+		case 46:
+			goto st151
+		case 58:
+			goto st215
+		case 93:
+			goto st303
 		}
-	st_case_90:
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st91
+				goto st220
 			}
 		case data[p] > 70:
 			if 97 <= data[p] && data[p] <= 102 {
-				goto st91
+				goto st217
 			}
 		default:
-			goto st91
+			goto st217
 		}
 		goto st0
-	st91:
+	st222:
 		if p++; p == pe {
-			goto _test_eof91
+			goto _test_eof222
+		}
+	st_case_222:
+		switch data[p] {
+		case 58:
+			goto st215
+		case 93:
+			goto st303
 		}
-	st_case_91:
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st143
+				goto st217
 			}
 		case data[p] > 70:
 			if 97 <= data[p] && data[p] <= 102 {
-				goto st143
+				goto st217
 			}
 		default:
-			goto st143
+			goto st217
 		}
 		goto st0
-	st92:
+	st223:
 		if p++; p == pe {
-			goto _test_eof92
+			goto _test_eof223
 		}
-	st_case_92:
+	st_case_223:
 		switch data[p] {
-		case 33:
-			goto st144
-		case 37:
-			goto st93
-		case 44:
-			goto st6
-		case 58:
-			goto st143
-		case 59:
-			goto st6
-		case 61:
-			goto st6
-		case 63:
-			goto st6
-		case 64:
-			goto tr14
-		case 91:
-			goto st128
+		case 50:
+			goto st231
 		case 93:
-			goto st128
-		case 95:
-			goto st144
-		case 126:
-			goto st144
+			goto st303
 		}
 		switch {
-		case data[p] < 65:
-			if 36 <= data[p] && data[p] <= 57 {
-				goto st144
+		case data[p] < 51:
+			if 48 <= data[p] && data[p] <= 49 {
+				goto st224
 			}
-		case data[p] > 90:
-			if 97 <= data[p] && data[p] <= 122 {
-				goto st144
+		case data[p] > 57:
+			switch {
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st235
+				}
+			case data[p] >= 65:
+				goto st235
 			}
 		default:
-			goto st144
+			goto st234
 		}
 		goto st0
-	st144:
+	st224:
 		if p++; p == pe {
-			goto _test_eof144
+			goto _test_eof224
 		}
-	st_case_144:
+	st_case_224:
 		switch data[p] {
-		case 33:
-			goto st144
-		case 37:
-			goto st93
-		case 44:
-			goto st6
+		case 46:
+			goto st151
 		case 58:
-			goto st143
-		case 59:
-			goto st84
-		case 61:
-			goto st6
-		case 63:
-			goto tr154
-		case 64:
-			goto tr14
-		case 91:
-			goto st128
+			goto st228
 		case 93:
-			goto st128
-		case 95:
-			goto st144
-		case 126:
-			goto st144
+			goto st303
 		}
 		switch {
 		case data[p] < 65:
-			if 36 <= data[p] && data[p] <= 57 {
-				goto st144
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st225
 			}
-		case data[p] > 90:
-			if 97 <= data[p] && data[p] <= 122 {
-				goto st144
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st230
 			}
 		default:
-			goto st144
+			goto st230
 		}
 		goto st0
-	st93:
+	st225:
 		if p++; p == pe {
-			goto _test_eof93
+			goto _test_eof225
+		}
+	st_case_225:
+		switch data[p] {
+		case 46:
+			goto st151
+		case 58:
+			goto st228
+		case 93:
+			goto st303
 		}
-	st_case_93:
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st94
+				goto st226
 			}
 		case data[p] > 70:
 			if 97 <= data[p] && data[p] <= 102 {
-				goto st94
+				goto st229
 			}
 		default:
-			goto st94
+			goto st229
 		}
 		goto st0
-	st94:
+	st226:
 		if p++; p == pe {
-			goto _test_eof94
+			goto _test_eof226
+		}
+	st_case_226:
+		switch data[p] {
+		case 46:
+			goto st151
+		case 58:
+			goto st228
+		case 93:
+			goto st303
 		}
-	st_case_94:
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st144
+				goto st227
 			}
 		case data[p] > 70:
 			if 97 <= data[p] && data[p] <= 102 {
-				goto st144
+				goto st227
 			}
 		default:
-			goto st144
+			goto st227
 		}
 		goto st0
-tr147:
-//line parser.rl:24
- uri.hostport = str[m:p] 
-//line parser.rl:20
- m = p 
-//line parser.rl:25
- uri.params   = str[m:p] 
-	goto st95
-tr154:
-//line parser.rl:25
- uri.params   = str[m:p] 
-	goto st95
-	st95:
+	st227:
 		if p++; p == pe {
-			goto _test_eof95
+			goto _test_eof227
 		}
-	st_case_95:
-//line parser_rl.go:3343
+	st_case_227:
 		switch data[p] {
-		case 33:
-			goto tr105
-		case 37:
-			goto tr106
-		case 38:
-			goto st6
-		case 44:
-			goto st6
 		case 58:
-			goto tr107
-		case 59:
-			goto st6
-		case 61:
-			goto st6
-		case 64:
-			goto tr14
-		case 91:
-			goto tr34
+			goto st228
 		case 93:
-			goto tr34
-		case 95:
-			goto tr105
-		case 126:
-			goto tr105
+			goto st303
+		}
+		goto st0
+	st228:
+		if p++; p == pe {
+			goto _test_eof228
+		}
+	st_case_228:
+		if data[p] == 50 {
+			goto st218
 		}
 		switch {
-		case data[p] < 63:
-			if 36 <= data[p] && data[p] <= 57 {
-				goto tr105
+		case data[p] < 51:
+			if 48 <= data[p] && data[p] <= 49 {
+				goto st211
 			}
-		case data[p] > 90:
-			if 97 <= data[p] && data[p] <= 122 {
-				goto tr105
+		case data[p] > 57:
+			switch {
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st222
+				}
+			case data[p] >= 65:
+				goto st222
 			}
 		default:
-			goto tr105
+			goto st221
 		}
 		goto st0
-tr105:
-//line parser.rl:20
- m = p 
-	goto st96
-	st96:
+	st229:
 		if p++; p == pe {
-			goto _test_eof96
+			goto _test_eof229
 		}
-	st_case_96:
-//line parser_rl.go:3392
+	st_case_229:
 		switch data[p] {
-		case 33:
-			goto st96
-		case 37:
-			goto st97
-		case 38:
-			goto st6
-		case 44:
-			goto st6
 		case 58:
-			goto st99
-		case 59:
-			goto st6
-		case 61:
-			goto st146
-		case 64:
-			goto tr14
-		case 91:
-			goto st26
+			goto st228
 		case 93:
-			goto st26
-		case 95:
-			goto st96
-		case 126:
-			goto st96
+			goto st303
 		}
 		switch {
-		case data[p] < 63:
-			if 36 <= data[p] && data[p] <= 57 {
-				goto st96
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st227
 			}
-		case data[p] > 90:
-			if 97 <= data[p] && data[p] <= 122 {
-				goto st96
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st227
 			}
 		default:
-			goto st96
+			goto st227
 		}
 		goto st0
-tr106:
-//line parser.rl:20
- m = p 
-	goto st97
-	st97:
+	st230:
 		if p++; p == pe {
-			goto _test_eof97
+			goto _test_eof230
+		}
+	st_case_230:
+		switch data[p] {
+		case 58:
+			goto st228
+		case 93:
+			goto st303
 		}
-	st_case_97:
-//line parser_rl.go:3441
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st98
+				goto st229
 			}
 		case data[p] > 70:
 			if 97 <= data[p] && data[p] <= 102 {
-				goto st98
+				goto st229
 			}
 		default:
-			goto st98
+			goto st229
 		}
 		goto st0
-	st98:
+	st231:
 		if p++; p == pe {
-			goto _test_eof98
+			goto _test_eof231
+		}
+	st_case_231:
+		switch data[p] {
+		case 46:
+			goto st151
+		case 53:
+			goto st232
+		case 58:
+			goto st228
+		case 93:
+			goto st303
 		}
-	st_case_98:
 		switch {
-		case data[p] < 65:
-			if 48 <= data[p] && data[p] <= 57 {
-				goto st96
+		case data[p] < 54:
+			if 48 <= data[p] && data[p] <= 52 {
+				goto st225
 			}
-		case data[p] > 70:
-			if 97 <= data[p] && data[p] <= 102 {
-				goto st96
+		case data[p] > 57:
+			switch {
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st230
+				}
+			case data[p] >= 65:
+				goto st230
 			}
 		default:
-			goto st96
+			goto st233
 		}
 		goto st0
-tr107:
-//line parser.rl:20
- m = p 
-	goto st99
-	st99:
+	st232:
+		if p++; p == pe {
+			goto _test_eof232
+		}
+	st_case_232:
+		switch data[p] {
+		case 46:
+			goto st151
+		case 58:
+			goto st228
+		case 93:
+			goto st303
+		}
+		switch {
+		case data[p] < 54:
+			if 48 <= data[p] && data[p] <= 53 {
+				goto st226
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st229
+				}
+			case data[p] >= 65:
+				goto st229
+			}
+		default:
+			goto st229
+		}
+		goto st0
+	st233:
 		if p++; p == pe {
-			goto _test_eof99
+			goto _test_eof233
 		}
-	st_case_99:
-//line parser_rl.go:3482
+	st_case_233:
 		switch data[p] {
-		case 33:
-			goto st99
-		case 37:
-			goto st100
-		case 38:
-			goto st9
-		case 44:
-			goto st9
-		case 47:
-			goto st26
+		case 46:
+			goto st151
 		case 58:
-			goto st26
-		case 61:
-			goto st145
-		case 63:
-			goto st26
-		case 64:
-			goto tr14
-		case 91:
-			goto st26
+			goto st228
 		case 93:
-			goto st26
-		case 95:
-			goto st99
-		case 126:
-			goto st99
+			goto st303
 		}
 		switch {
 		case data[p] < 65:
-			if 36 <= data[p] && data[p] <= 57 {
-				goto st99
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st229
 			}
-		case data[p] > 90:
-			if 97 <= data[p] && data[p] <= 122 {
-				goto st99
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st229
 			}
 		default:
-			goto st99
+			goto st229
 		}
 		goto st0
-	st100:
+	st234:
 		if p++; p == pe {
-			goto _test_eof100
+			goto _test_eof234
+		}
+	st_case_234:
+		switch data[p] {
+		case 46:
+			goto st151
+		case 58:
+			goto st228
+		case 93:
+			goto st303
 		}
-	st_case_100:
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st101
+				goto st233
 			}
 		case data[p] > 70:
 			if 97 <= data[p] && data[p] <= 102 {
-				goto st101
+				goto st230
 			}
 		default:
-			goto st101
+			goto st230
 		}
 		goto st0
-	st101:
+	st235:
 		if p++; p == pe {
-			goto _test_eof101
+			goto _test_eof235
+		}
+	st_case_235:
+		switch data[p] {
+		case 58:
+			goto st228
+		case 93:
+			goto st303
 		}
-	st_case_101:
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st99
+				goto st230
 			}
 		case data[p] > 70:
 			if 97 <= data[p] && data[p] <= 102 {
-				goto st99
+				goto st230
 			}
 		default:
-			goto st99
+			goto st230
 		}
 		goto st0
-	st145:
+	st236:
 		if p++; p == pe {
-			goto _test_eof145
+			goto _test_eof236
 		}
-	st_case_145:
+	st_case_236:
 		switch data[p] {
-		case 33:
-			goto st145
-		case 37:
-			goto st102
-		case 38:
-			goto st104
-		case 44:
-			goto st9
-		case 47:
-			goto st129
-		case 58:
-			goto st129
-		case 61:
-			goto st9
-		case 63:
-			goto st129
-		case 64:
-			goto tr14
-		case 91:
-			goto st129
+		case 50:
+			goto st244
 		case 93:
-			goto st129
-		case 95:
-			goto st145
-		case 126:
-			goto st145
+			goto st303
 		}
 		switch {
-		case data[p] < 65:
-			if 36 <= data[p] && data[p] <= 57 {
-				goto st145
+		case data[p] < 51:
+			if 48 <= data[p] && data[p] <= 49 {
+				goto st237
 			}
-		case data[p] > 90:
-			if 97 <= data[p] && data[p] <= 122 {
-				goto st145
+		case data[p] > 57:
+			switch {
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st248
+				}
+			case data[p] >= 65:
+				goto st248
 			}
 		default:
-			goto st145
+			goto st247
 		}
 		goto st0
-	st102:
+	st237:
 		if p++; p == pe {
-			goto _test_eof102
+			goto _test_eof237
+		}
+	st_case_237:
+		switch data[p] {
+		case 46:
+			goto st151
+		case 58:
+			goto st241
+		case 93:
+			goto st303
 		}
-	st_case_102:
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st103
+				goto st238
 			}
 		case data[p] > 70:
 			if 97 <= data[p] && data[p] <= 102 {
-				goto st103
+				goto st243
 			}
 		default:
-			goto st103
+			goto st243
 		}
 		goto st0
-	st103:
+	st238:
 		if p++; p == pe {
-			goto _test_eof103
+			goto _test_eof238
+		}
+	st_case_238:
+		switch data[p] {
+		case 46:
+			goto st151
+		case 58:
+			goto st241
+		case 93:
+			goto st303
 		}
-	st_case_103:
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st145
+				goto st239
 			}
 		case data[p] > 70:
 			if 97 <= data[p] && data[p] <= 102 {
-				goto st145
+				goto st242
 			}
 		default:
-			goto st145
+			goto st242
 		}
 		goto st0
-	st104:
+	st239:
 		if p++; p == pe {
-			goto _test_eof104
+			goto _test_eof239
 		}
-	st_case_104:
+	st_case_239:
 		switch data[p] {
-		case 33:
-			goto st99
-		case 37:
-			goto st100
-		case 38:
-			goto st9
-		case 44:
-			goto st9
-		case 47:
-			goto st26
+		case 46:
+			goto st151
 		case 58:
-			goto st26
-		case 61:
-			goto st9
-		case 63:
-			goto st26
-		case 64:
-			goto tr14
-		case 91:
-			goto st26
+			goto st241
 		case 93:
-			goto st26
-		case 95:
-			goto st99
-		case 126:
-			goto st99
+			goto st303
 		}
 		switch {
 		case data[p] < 65:
-			if 36 <= data[p] && data[p] <= 57 {
-				goto st99
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st240
 			}
-		case data[p] > 90:
-			if 97 <= data[p] && data[p] <= 122 {
-				goto st99
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st240
 			}
 		default:
-			goto st99
+			goto st240
 		}
 		goto st0
-	st146:
+	st240:
 		if p++; p == pe {
-			goto _test_eof146
+			goto _test_eof240
 		}
-	st_case_146:
+	st_case_240:
 		switch data[p] {
-		case 33:
-			goto st146
-		case 37:
-			goto st105
-		case 38:
-			goto st107
-		case 44:
-			goto st6
 		case 58:
-			goto st145
-		case 59:
-			goto st6
-		case 61:
-			goto st6
-		case 64:
-			goto tr14
-		case 91:
-			goto st129
+			goto st241
 		case 93:
-			goto st129
-		case 95:
-			goto st146
-		case 126:
-			goto st146
+			goto st303
+		}
+		goto st0
+	st241:
+		if p++; p == pe {
+			goto _test_eof241
+		}
+	st_case_241:
+		if data[p] == 50 {
+			goto st231
 		}
 		switch {
-		case data[p] < 63:
-			if 36 <= data[p] && data[p] <= 57 {
-				goto st146
+		case data[p] < 51:
+			if 48 <= data[p] && data[p] <= 49 {
+				goto st224
 			}
-		case data[p] > 90:
-			if 97 <= data[p] && data[p] <= 122 {
-				goto st146
+		case data[p] > 57:
+			switch {
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st235
+				}
+			case data[p] >= 65:
+				goto st235
 			}
 		default:
-			goto st146
+			goto st234
 		}
 		goto st0
-	st105:
+	st242:
 		if p++; p == pe {
-			goto _test_eof105
+			goto _test_eof242
+		}
+	st_case_242:
+		switch data[p] {
+		case 58:
+			goto st241
+		case 93:
+			goto st303
 		}
-	st_case_105:
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st106
+				goto st240
 			}
 		case data[p] > 70:
 			if 97 <= data[p] && data[p] <= 102 {
-				goto st106
+				goto st240
 			}
 		default:
-			goto st106
+			goto st240
 		}
 		goto st0
-	st106:
+	st243:
 		if p++; p == pe {
-			goto _test_eof106
+			goto _test_eof243
+		}
+	st_case_243:
+		switch data[p] {
+		case 58:
+			goto st241
+		case 93:
+			goto st303
 		}
-	st_case_106:
 		switch {
 		case data[p] < 65:
 			if 48 <= data[p] && data[p] <= 57 {
-				goto st146
+				goto st242
 			}
 		case data[p] > 70:
 			if 97 <= data[p] && data[p] <= 102 {
-				goto st146
+				goto st242
 			}
 		default:
-			goto st146
+			goto st242
 		}
 		goto st0
-	st107:
+	st244:
 		if p++; p == pe {
-			goto _test_eof107
+			goto _test_eof244
 		}
-	st_case_107:
+	st_case_244:
 		switch data[p] {
-		case 33:
-			goto st96
-		case 37:
-			goto st97
-		case 38:
-			goto st6
-		case 44:
-			goto st6
+		case 46:
+			goto st151
+		case 53:
+			goto st245
 		case 58:
-			goto st99
-		case 59:
-			goto st6
-		case 61:
-			goto st6
-		case 64:
-			goto tr14
-		case 91:
-			goto st26
+			goto st241
 		case 93:
-			goto st26
-		case 95:
-			goto st96
-		case 126:
-			goto st96
+			goto st303
 		}
 		switch {
-		case data[p] < 63:
-			if 36 <= data[p] && data[p] <= 57 {
-				goto st96
+		case data[p] < 54:
+			if 48 <= data[p] && data[p] <= 52 {
+				goto st238
 			}
-		case data[p] > 90:
-			if 97 <= data[p] && data[p] <= 122 {
-				goto st96
+		case data[p] > 57:
+			switch {
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st243
+				}
+			case data[p] >= 65:
+				goto st243
 			}
 		default:
-			goto st96
+			goto st246
 		}
 		goto st0
-	st108:
+	st245:
 		if p++; p == pe {
-			goto _test_eof108
+			goto _test_eof245
 		}
-	st_case_108:
+	st_case_245:
 		switch data[p] {
-		case 33:
-			goto st6
-		case 37:
-			goto st7
+		case 46:
+			goto st151
 		case 58:
-			goto st9
-		case 59:
-			goto st6
-		case 61:
-			goto st6
-		case 63:
-			goto st6
-		case 64:
-			goto tr14
-		case 95:
-			goto st6
-		case 126:
-			goto st6
+			goto st241
+		case 93:
+			goto st303
 		}
 		switch {
-		case data[p] < 48:
-			if 36 <= data[p] && data[p] <= 47 {
-				goto st6
+		case data[p] < 54:
+			if 48 <= data[p] && data[p] <= 53 {
+				goto st239
 			}
 		case data[p] > 57:
 			switch {
-			case data[p] > 90:
-				if 97 <= data[p] && data[p] <= 122 {
-					goto st134
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st242
 				}
 			case data[p] >= 65:
-				goto st134
+				goto st242
+			}
+		default:
+			goto st242
+		}
+		goto st0
+	st246:
+		if p++; p == pe {
+			goto _test_eof246
+		}
+	st_case_246:
+		switch data[p] {
+		case 46:
+			goto st151
+		case 58:
+			goto st241
+		case 93:
+			goto st303
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st242
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st242
 			}
 		default:
-			goto st109
+			goto st242
 		}
 		goto st0
-	st109:
+	st247:
 		if p++; p == pe {
-			goto _test_eof109
+			goto _test_eof247
 		}
-	st_case_109:
+	st_case_247:
 		switch data[p] {
-		case 33:
-			goto st6
-		case 37:
-			goto st7
-		case 45:
-			goto st79
 		case 46:
-			goto st110
+			goto st151
 		case 58:
-			goto st9
-		case 59:
-			goto st6
-		case 61:
-			goto st6
-		case 63:
-			goto st6
-		case 64:
-			goto tr14
-		case 95:
-			goto st6
-		case 126:
-			goto st6
+			goto st241
+		case 93:
+			goto st303
 		}
 		switch {
-		case data[p] < 48:
-			if 36 <= data[p] && data[p] <= 47 {
-				goto st6
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st246
 			}
-		case data[p] > 57:
-			switch {
-			case data[p] > 90:
-				if 97 <= data[p] && data[p] <= 122 {
-					goto st80
-				}
-			case data[p] >= 65:
-				goto st80
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st243
 			}
 		default:
-			goto st115
+			goto st243
 		}
 		goto st0
-	st110:
+	st248:
 		if p++; p == pe {
-			goto _test_eof110
+			goto _test_eof248
 		}
-	st_case_110:
+	st_case_248:
 		switch data[p] {
-		case 33:
-			goto st6
-		case 37:
-			goto st7
 		case 58:
-			goto st9
-		case 59:
-			goto st6
-		case 61:
-			goto st6
-		case 63:
-			goto st6
-		case 64:
-			goto tr14
-		case 95:
-			goto st6
-		case 126:
-			goto st6
+			goto st241
+		case 93:
+			goto st303
 		}
 		switch {
-		case data[p] < 48:
-			if 36 <= data[p] && data[p] <= 47 {
-				goto st6
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st243
 			}
-		case data[p] > 57:
-			switch {
-			case data[p] > 90:
-				if 97 <= data[p] && data[p] <= 122 {
-					goto st134
-				}
-			case data[p] >= 65:
-				goto st134
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st243
 			}
 		default:
-			goto st111
+			goto st243
 		}
 		goto st0
-	st111:
+	st249:
 		if p++; p == pe {
-			goto _test_eof111
+			goto _test_eof249
 		}
-	st_case_111:
+	st_case_249:
+		if data[p] == 58 {
+			goto st250
+		}
+		goto st0
+	st250:
+		if p++; p == pe {
+			goto _test_eof250
+		}
+	st_case_250:
 		switch data[p] {
-		case 33:
-			goto st6
-		case 37:
-			goto st7
-		case 45:
-			goto st79
-		case 46:
-			goto st112
-		case 58:
-			goto st9
-		case 59:
-			goto st6
-		case 61:
-			goto st6
-		case 63:
-			goto st6
-		case 64:
-			goto tr14
-		case 95:
-			goto st6
-		case 126:
-			goto st6
+		case 50:
+			goto st258
+		case 93:
+			goto st303
 		}
 		switch {
-		case data[p] < 48:
-			if 36 <= data[p] && data[p] <= 47 {
-				goto st6
+		case data[p] < 51:
+			if 48 <= data[p] && data[p] <= 49 {
+				goto st251
 			}
 		case data[p] > 57:
 			switch {
-			case data[p] > 90:
-				if 97 <= data[p] && data[p] <= 122 {
-					goto st80
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st262
 				}
 			case data[p] >= 65:
-				goto st80
+				goto st262
 			}
 		default:
-			goto st113
+			goto st261
 		}
 		goto st0
-	st112:
+	st251:
 		if p++; p == pe {
-			goto _test_eof112
+			goto _test_eof251
 		}
-	st_case_112:
+	st_case_251:
 		switch data[p] {
-		case 33:
-			goto st6
-		case 37:
-			goto st7
+		case 46:
+			goto st151
 		case 58:
-			goto st9
-		case 59:
-			goto st6
-		case 61:
-			goto st6
-		case 63:
-			goto st6
-		case 64:
-			goto tr14
-		case 95:
-			goto st6
-		case 126:
-			goto st6
+			goto st255
+		case 93:
+			goto st303
 		}
 		switch {
-		case data[p] < 48:
-			if 36 <= data[p] && data[p] <= 47 {
-				goto st6
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st252
 			}
-		case data[p] > 57:
-			switch {
-			case data[p] > 90:
-				if 97 <= data[p] && data[p] <= 122 {
-					goto st134
-				}
-			case data[p] >= 65:
-				goto st134
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st257
 			}
 		default:
-			goto st147
+			goto st257
 		}
 		goto st0
-	st147:
+	st252:
 		if p++; p == pe {
-			goto _test_eof147
+			goto _test_eof252
 		}
-	st_case_147:
+	st_case_252:
 		switch data[p] {
-		case 33:
-			goto st6
-		case 37:
-			goto st7
-		case 45:
-			goto st79
 		case 46:
-			goto st81
+			goto st151
 		case 58:
-			goto st83
-		case 59:
-			goto tr146
-		case 61:
-			goto st6
-		case 63:
-			goto tr147
-		case 64:
-			goto tr14
-		case 95:
-			goto st6
-		case 126:
-			goto st6
+			goto st255
+		case 93:
+			goto st303
 		}
 		switch {
-		case data[p] < 48:
-			if 36 <= data[p] && data[p] <= 47 {
-				goto st6
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st253
 			}
-		case data[p] > 57:
-			switch {
-			case data[p] > 90:
-				if 97 <= data[p] && data[p] <= 122 {
-					goto st80
-				}
-			case data[p] >= 65:
-				goto st80
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st256
 			}
 		default:
-			goto st148
+			goto st256
 		}
 		goto st0
-	st148:
+	st253:
 		if p++; p == pe {
-			goto _test_eof148
+			goto _test_eof253
 		}
-	st_case_148:
+	st_case_253:
 		switch data[p] {
-		case 33:
-			goto st6
-		case 37:
-			goto st7
-		case 45:
-			goto st79
 		case 46:
-			goto st81
+			goto st151
 		case 58:
-			goto st83
-		case 59:
-			goto tr146
-		case 61:
-			goto st6
-		case 63:
-			goto tr147
-		case 64:
-			goto tr14
-		case 95:
-			goto st6
-		case 126:
-			goto st6
+			goto st255
+		case 93:
+			goto st303
 		}
 		switch {
-		case data[p] < 48:
-			if 36 <= data[p] && data[p] <= 47 {
-				goto st6
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st254
 			}
-		case data[p] > 57:
-			switch {
-			case data[p] > 90:
-				if 97 <= data[p] && data[p] <= 122 {
-					goto st80
-				}
-			case data[p] >= 65:
-				goto st80
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st254
 			}
 		default:
-			goto st149
+			goto st254
 		}
 		goto st0
-	st149:
+	st254:
 		if p++; p == pe {
-			goto _test_eof149
+			goto _test_eof254
 		}
-	st_case_149:
+	st_case_254:
 		switch data[p] {
-		case 33:
-			goto st6
-		case 37:
-			goto st7
-		case 45:
-			goto st79
-		case 46:
-			goto st81
 		case 58:
-			goto st83
-		case 59:
-			goto tr146
-		case 61:
-			goto st6
-		case 63:
-			goto tr147
-		case 64:
-			goto tr14
-		case 95:
-			goto st6
-		case 126:
-			goto st6
+			goto st255
+		case 93:
+			goto st303
+		}
+		goto st0
+	st255:
+		if p++; p == pe {
+			goto _test_eof255
+		}
+	st_case_255:
+		if data[p] == 50 {
+			goto st244
 		}
 		switch {
-		case data[p] < 48:
-			if 36 <= data[p] && data[p] <= 47 {
-				goto st6
+		case data[p] < 51:
+			if 48 <= data[p] && data[p] <= 49 {
+				goto st237
 			}
 		case data[p] > 57:
 			switch {
-			case data[p] > 90:
-				if 97 <= data[p] && data[p] <= 122 {
-					goto st80
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st248
 				}
 			case data[p] >= 65:
-				goto st80
+				goto st248
 			}
 		default:
-			goto st80
+			goto st247
 		}
 		goto st0
-	st113:
+	st256:
 		if p++; p == pe {
-			goto _test_eof113
+			goto _test_eof256
 		}
-	st_case_113:
-		switch data[p] {
-		case 33:
-			goto st6
-		case 37:
-			goto st7
-		case 45:
-			goto st79
-		case 46:
-			goto st112
-		case 58:
-			goto st9
-		case 59:
-			goto st6
-		case 61:
-			goto st6
-		case 63:
-			goto st6
-		case 64:
-			goto tr14
-		case 95:
-			goto st6
-		case 126:
-			goto st6
+	st_case_256:
+		switch data[p] {
+		case 58:
+			goto st255
+		case 93:
+			goto st303
 		}
 		switch {
-		case data[p] < 48:
-			if 36 <= data[p] && data[p] <= 47 {
-				goto st6
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st254
 			}
-		case data[p] > 57:
-			switch {
-			case data[p] > 90:
-				if 97 <= data[p] && data[p] <= 122 {
-					goto st80
-				}
-			case data[p] >= 65:
-				goto st80
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st254
 			}
 		default:
-			goto st114
+			goto st254
 		}
 		goto st0
-	st114:
+	st257:
 		if p++; p == pe {
-			goto _test_eof114
+			goto _test_eof257
 		}
-	st_case_114:
+	st_case_257:
 		switch data[p] {
-		case 33:
-			goto st6
-		case 37:
-			goto st7
-		case 45:
-			goto st79
-		case 46:
-			goto st112
 		case 58:
-			goto st9
-		case 59:
-			goto st6
-		case 61:
-			goto st6
-		case 63:
-			goto st6
-		case 64:
-			goto tr14
-		case 95:
-			goto st6
-		case 126:
-			goto st6
+			goto st255
+		case 93:
+			goto st303
 		}
 		switch {
-		case data[p] < 48:
-			if 36 <= data[p] && data[p] <= 47 {
-				goto st6
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st256
 			}
-		case data[p] > 57:
-			switch {
-			case data[p] > 90:
-				if 97 <= data[p] && data[p] <= 122 {
-					goto st80
-				}
-			case data[p] >= 65:
-				goto st80
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st256
 			}
 		default:
-			goto st80
+			goto st256
 		}
 		goto st0
-	st115:
+	st258:
 		if p++; p == pe {
-			goto _test_eof115
+			goto _test_eof258
 		}
-	st_case_115:
+	st_case_258:
 		switch data[p] {
-		case 33:
-			goto st6
-		case 37:
-			goto st7
-		case 45:
-			goto st79
 		case 46:
-			goto st110
+			goto st151
+		case 53:
+			goto st259
 		case 58:
-			goto st9
-		case 59:
-			goto st6
-		case 61:
-			goto st6
-		case 63:
-			goto st6
-		case 64:
-			goto tr14
-		case 95:
-			goto st6
-		case 126:
-			goto st6
+			goto st255
+		case 93:
+			goto st303
 		}
 		switch {
-		case data[p] < 48:
-			if 36 <= data[p] && data[p] <= 47 {
-				goto st6
+		case data[p] < 54:
+			if 48 <= data[p] && data[p] <= 52 {
+				goto st252
 			}
 		case data[p] > 57:
 			switch {
-			case data[p] > 90:
-				if 97 <= data[p] && data[p] <= 122 {
-					goto st80
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st257
 				}
 			case data[p] >= 65:
-				goto st80
+				goto st257
 			}
 		default:
-			goto st116
+			goto st260
 		}
 		goto st0
-	st116:
+	st259:
 		if p++; p == pe {
-			goto _test_eof116
+			goto _test_eof259
 		}
-	st_case_116:
+	st_case_259:
 		switch data[p] {
-		case 33:
-			goto st6
-		case 37:
-			goto st7
-		case 45:
-			goto st79
 		case 46:
-			goto st110
+			goto st151
 		case 58:
-			goto st9
-		case 59:
-			goto st6
-		case 61:
-			goto st6
-		case 63:
-			goto st6
-		case 64:
-			goto tr14
-		case 95:
-			goto st6
-		case 126:
-			goto st6
+			goto st255
+		case 93:
+			goto st303
 		}
 		switch {
-		case data[p] < 48:
-			if 36 <= data[p] && data[p] <= 47 {
-				goto st6
+		case data[p] < 54:
+			if 48 <= data[p] && data[p] <= 53 {
+				goto st253
 			}
 		case data[p] > 57:
 			switch {
-			case data[p] > 90:
-				if 97 <= data[p] && data[p] <= 122 {
-					goto st80
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st256
 				}
 			case data[p] >= 65:
-				goto st80
+				goto st256
 			}
 		default:
-			goto st80
+			goto st256
 		}
 		goto st0
-	st117:
+	st260:
 		if p++; p == pe {
-			goto _test_eof117
+			goto _test_eof260
 		}
-	st_case_117:
+	st_case_260:
 		switch data[p] {
-		case 33:
-			goto st6
-		case 37:
-			goto st7
-		case 45:
-			goto st79
 		case 46:
-			goto st108
+			goto st151
 		case 58:
-			goto st9
-		case 59:
-			goto st6
-		case 61:
-			goto st6
-		case 63:
-			goto st6
-		case 64:
-			goto tr14
-		case 95:
-			goto st6
-		case 126:
-			goto st6
+			goto st255
+		case 93:
+			goto st303
 		}
 		switch {
-		case data[p] < 48:
-			if 36 <= data[p] && data[p] <= 47 {
-				goto st6
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st256
 			}
-		case data[p] > 57:
-			switch {
-			case data[p] > 90:
-				if 97 <= data[p] && data[p] <= 122 {
-					goto st80
-				}
-			case data[p] >= 65:
-				goto st80
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st256
 			}
 		default:
-			goto st118
+			goto st256
 		}
 		goto st0
-	st118:
+	st261:
 		if p++; p == pe {
-			goto _test_eof118
+			goto _test_eof261
 		}
-	st_case_118:
+	st_case_261:
 		switch data[p] {
-		case 33:
-			goto st6
-		case 37:
-			goto st7
-		case 45:
-			goto st79
 		case 46:
-			goto st108
+			goto st151
 		case 58:
-			goto st9
-		case 59:
-			goto st6
-		case 61:
-			goto st6
-		case 63:
-			goto st6
-		case 64:
-			goto tr14
-		case 95:
-			goto st6
-		case 126:
-			goto st6
+			goto st255
+		case 93:
+			goto st303
 		}
 		switch {
-		case data[p] < 48:
-			if 36 <= data[p] && data[p] <= 47 {
-				goto st6
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st260
 			}
-		case data[p] > 57:
-			switch {
-			case data[p] > 90:
-				if 97 <= data[p] && data[p] <= 122 {
-					goto st80
-				}
-			case data[p] >= 65:
-				goto st80
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st257
 			}
 		default:
-			goto st80
+			goto st257
 		}
 		goto st0
-	st119:
+	st262:
 		if p++; p == pe {
-			goto _test_eof119
+			goto _test_eof262
 		}
-	st_case_119:
-		if data[p] == 58 {
-			goto tr128
+	st_case_262:
+		switch data[p] {
+		case 58:
+			goto st255
+		case 93:
+			goto st303
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st257
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st257
+			}
+		default:
+			goto st257
 		}
 		goto st0
 	st_out:
+	_test_eof1: cs = 1; goto _test_eof
 	_test_eof2: cs = 2; goto _test_eof
 	_test_eof3: cs = 3; goto _test_eof
 	_test_eof4: cs = 4; goto _test_eof
@@ -4479,28 +8817,18 @@ tr107:
 	_test_eof14: cs = 14; goto _test_eof
 	_test_eof15: cs = 15; goto _test_eof
 	_test_eof16: cs = 16; goto _test_eof
-	_test_eof120: cs = 120; goto _test_eof
 	_test_eof17: cs = 17; goto _test_eof
-	_test_eof121: cs = 121; goto _test_eof
 	_test_eof18: cs = 18; goto _test_eof
-	_test_eof122: cs = 122; goto _test_eof
-	_test_eof123: cs = 123; goto _test_eof
-	_test_eof124: cs = 124; goto _test_eof
-	_test_eof125: cs = 125; goto _test_eof
-	_test_eof126: cs = 126; goto _test_eof
 	_test_eof19: cs = 19; goto _test_eof
-	_test_eof127: cs = 127; goto _test_eof
 	_test_eof20: cs = 20; goto _test_eof
 	_test_eof21: cs = 21; goto _test_eof
 	_test_eof22: cs = 22; goto _test_eof
-	_test_eof128: cs = 128; goto _test_eof
 	_test_eof23: cs = 23; goto _test_eof
 	_test_eof24: cs = 24; goto _test_eof
 	_test_eof25: cs = 25; goto _test_eof
 	_test_eof26: cs = 26; goto _test_eof
 	_test_eof27: cs = 27; goto _test_eof
 	_test_eof28: cs = 28; goto _test_eof
-	_test_eof129: cs = 129; goto _test_eof
 	_test_eof29: cs = 29; goto _test_eof
 	_test_eof30: cs = 30; goto _test_eof
 	_test_eof31: cs = 31; goto _test_eof
@@ -4509,34 +8837,57 @@ tr107:
 	_test_eof34: cs = 34; goto _test_eof
 	_test_eof35: cs = 35; goto _test_eof
 	_test_eof36: cs = 36; goto _test_eof
-	_test_eof130: cs = 130; goto _test_eof
-	_test_eof131: cs = 131; goto _test_eof
-	_test_eof132: cs = 132; goto _test_eof
 	_test_eof37: cs = 37; goto _test_eof
 	_test_eof38: cs = 38; goto _test_eof
 	_test_eof39: cs = 39; goto _test_eof
 	_test_eof40: cs = 40; goto _test_eof
 	_test_eof41: cs = 41; goto _test_eof
 	_test_eof42: cs = 42; goto _test_eof
+	_test_eof263: cs = 263; goto _test_eof
 	_test_eof43: cs = 43; goto _test_eof
+	_test_eof264: cs = 264; goto _test_eof
 	_test_eof44: cs = 44; goto _test_eof
+	_test_eof265: cs = 265; goto _test_eof
 	_test_eof45: cs = 45; goto _test_eof
 	_test_eof46: cs = 46; goto _test_eof
+	_test_eof266: cs = 266; goto _test_eof
+	_test_eof267: cs = 267; goto _test_eof
 	_test_eof47: cs = 47; goto _test_eof
+	_test_eof268: cs = 268; goto _test_eof
+	_test_eof269: cs = 269; goto _test_eof
+	_test_eof270: cs = 270; goto _test_eof
 	_test_eof48: cs = 48; goto _test_eof
 	_test_eof49: cs = 49; goto _test_eof
+	_test_eof271: cs = 271; goto _test_eof
 	_test_eof50: cs = 50; goto _test_eof
 	_test_eof51: cs = 51; goto _test_eof
+	_test_eof272: cs = 272; goto _test_eof
+	_test_eof273: cs = 273; goto _test_eof
+	_test_eof274: cs = 274; goto _test_eof
+	_test_eof275: cs = 275; goto _test_eof
+	_test_eof276: cs = 276; goto _test_eof
+	_test_eof277: cs = 277; goto _test_eof
+	_test_eof278: cs = 278; goto _test_eof
+	_test_eof279: cs = 279; goto _test_eof
+	_test_eof280: cs = 280; goto _test_eof
+	_test_eof281: cs = 281; goto _test_eof
+	_test_eof282: cs = 282; goto _test_eof
+	_test_eof283: cs = 283; goto _test_eof
 	_test_eof52: cs = 52; goto _test_eof
+	_test_eof284: cs = 284; goto _test_eof
+	_test_eof285: cs = 285; goto _test_eof
+	_test_eof286: cs = 286; goto _test_eof
 	_test_eof53: cs = 53; goto _test_eof
 	_test_eof54: cs = 54; goto _test_eof
 	_test_eof55: cs = 55; goto _test_eof
 	_test_eof56: cs = 56; goto _test_eof
+	_test_eof287: cs = 287; goto _test_eof
 	_test_eof57: cs = 57; goto _test_eof
-	_test_eof133: cs = 133; goto _test_eof
 	_test_eof58: cs = 58; goto _test_eof
 	_test_eof59: cs = 59; goto _test_eof
+	_test_eof288: cs = 288; goto _test_eof
 	_test_eof60: cs = 60; goto _test_eof
+	_test_eof289: cs = 289; goto _test_eof
 	_test_eof61: cs = 61; goto _test_eof
 	_test_eof62: cs = 62; goto _test_eof
 	_test_eof63: cs = 63; goto _test_eof
@@ -4547,6 +8898,7 @@ tr107:
 	_test_eof68: cs = 68; goto _test_eof
 	_test_eof69: cs = 69; goto _test_eof
 	_test_eof70: cs = 70; goto _test_eof
+	_test_eof290: cs = 290; goto _test_eof
 	_test_eof71: cs = 71; goto _test_eof
 	_test_eof72: cs = 72; goto _test_eof
 	_test_eof73: cs = 73; goto _test_eof
@@ -4557,54 +8909,50 @@ tr107:
 	_test_eof78: cs = 78; goto _test_eof
 	_test_eof79: cs = 79; goto _test_eof
 	_test_eof80: cs = 80; goto _test_eof
+	_test_eof291: cs = 291; goto _test_eof
 	_test_eof81: cs = 81; goto _test_eof
-	_test_eof134: cs = 134; goto _test_eof
 	_test_eof82: cs = 82; goto _test_eof
-	_test_eof135: cs = 135; goto _test_eof
 	_test_eof83: cs = 83; goto _test_eof
-	_test_eof136: cs = 136; goto _test_eof
-	_test_eof137: cs = 137; goto _test_eof
-	_test_eof138: cs = 138; goto _test_eof
-	_test_eof139: cs = 139; goto _test_eof
-	_test_eof140: cs = 140; goto _test_eof
 	_test_eof84: cs = 84; goto _test_eof
-	_test_eof141: cs = 141; goto _test_eof
 	_test_eof85: cs = 85; goto _test_eof
 	_test_eof86: cs = 86; goto _test_eof
-	_test_eof142: cs = 142; goto _test_eof
+	_test_eof292: cs = 292; goto _test_eof
 	_test_eof87: cs = 87; goto _test_eof
+	_test_eof293: cs = 293; goto _test_eof
 	_test_eof88: cs = 88; goto _test_eof
+	_test_eof294: cs = 294; goto _test_eof
 	_test_eof89: cs = 89; goto _test_eof
-	_test_eof143: cs = 143; goto _test_eof
+	_test_eof295: cs = 295; goto _test_eof
 	_test_eof90: cs = 90; goto _test_eof
 	_test_eof91: cs = 91; goto _test_eof
 	_test_eof92: cs = 92; goto _test_eof
-	_test_eof144: cs = 144; goto _test_eof
+	_test_eof296: cs = 296; goto _test_eof
 	_test_eof93: cs = 93; goto _test_eof
 	_test_eof94: cs = 94; goto _test_eof
 	_test_eof95: cs = 95; goto _test_eof
 	_test_eof96: cs = 96; goto _test_eof
 	_test_eof97: cs = 97; goto _test_eof
 	_test_eof98: cs = 98; goto _test_eof
+	_test_eof297: cs = 297; goto _test_eof
 	_test_eof99: cs = 99; goto _test_eof
 	_test_eof100: cs = 100; goto _test_eof
 	_test_eof101: cs = 101; goto _test_eof
-	_test_eof145: cs = 145; goto _test_eof
 	_test_eof102: cs = 102; goto _test_eof
 	_test_eof103: cs = 103; goto _test_eof
 	_test_eof104: cs = 104; goto _test_eof
-	_test_eof146: cs = 146; goto _test_eof
 	_test_eof105: cs = 105; goto _test_eof
 	_test_eof106: cs = 106; goto _test_eof
+	_test_eof298: cs = 298; goto _test_eof
+	_test_eof299: cs = 299; goto _test_eof
+	_test_eof300: cs = 300; goto _test_eof
+	_test_eof301: cs = 301; goto _test_eof
+	_test_eof302: cs = 302; goto _test_eof
 	_test_eof107: cs = 107; goto _test_eof
 	_test_eof108: cs = 108; goto _test_eof
 	_test_eof109: cs = 109; goto _test_eof
 	_test_eof110: cs = 110; goto _test_eof
 	_test_eof111: cs = 111; goto _test_eof
 	_test_eof112: cs = 112; goto _test_eof
-	_test_eof147: cs = 147; goto _test_eof
-	_test_eof148: cs = 148; goto _test_eof
-	_test_eof149: cs = 149; goto _test_eof
 	_test_eof113: cs = 113; goto _test_eof
 	_test_eof114: cs = 114; goto _test_eof
 	_test_eof115: cs = 115; goto _test_eof
@@ -4612,36 +8960,204 @@ tr107:
 	_test_eof117: cs = 117; goto _test_eof
 	_test_eof118: cs = 118; goto _test_eof
 	_test_eof119: cs = 119; goto _test_eof
+	_test_eof120: cs = 120; goto _test_eof
+	_test_eof121: cs = 121; goto _test_eof
+	_test_eof122: cs = 122; goto _test_eof
+	_test_eof123: cs = 123; goto _test_eof
+	_test_eof124: cs = 124; goto _test_eof
+	_test_eof125: cs = 125; goto _test_eof
+	_test_eof126: cs = 126; goto _test_eof
+	_test_eof127: cs = 127; goto _test_eof
+	_test_eof128: cs = 128; goto _test_eof
+	_test_eof129: cs = 129; goto _test_eof
+	_test_eof130: cs = 130; goto _test_eof
+	_test_eof131: cs = 131; goto _test_eof
+	_test_eof132: cs = 132; goto _test_eof
+	_test_eof133: cs = 133; goto _test_eof
+	_test_eof134: cs = 134; goto _test_eof
+	_test_eof135: cs = 135; goto _test_eof
+	_test_eof136: cs = 136; goto _test_eof
+	_test_eof137: cs = 137; goto _test_eof
+	_test_eof138: cs = 138; goto _test_eof
+	_test_eof139: cs = 139; goto _test_eof
+	_test_eof140: cs = 140; goto _test_eof
+	_test_eof141: cs = 141; goto _test_eof
+	_test_eof142: cs = 142; goto _test_eof
+	_test_eof143: cs = 143; goto _test_eof
+	_test_eof144: cs = 144; goto _test_eof
+	_test_eof145: cs = 145; goto _test_eof
+	_test_eof146: cs = 146; goto _test_eof
+	_test_eof147: cs = 147; goto _test_eof
+	_test_eof148: cs = 148; goto _test_eof
+	_test_eof149: cs = 149; goto _test_eof
+	_test_eof150: cs = 150; goto _test_eof
+	_test_eof151: cs = 151; goto _test_eof
+	_test_eof152: cs = 152; goto _test_eof
+	_test_eof153: cs = 153; goto _test_eof
+	_test_eof154: cs = 154; goto _test_eof
+	_test_eof155: cs = 155; goto _test_eof
+	_test_eof156: cs = 156; goto _test_eof
+	_test_eof157: cs = 157; goto _test_eof
+	_test_eof158: cs = 158; goto _test_eof
+	_test_eof303: cs = 303; goto _test_eof
+	_test_eof159: cs = 159; goto _test_eof
+	_test_eof160: cs = 160; goto _test_eof
+	_test_eof161: cs = 161; goto _test_eof
+	_test_eof162: cs = 162; goto _test_eof
+	_test_eof163: cs = 163; goto _test_eof
+	_test_eof164: cs = 164; goto _test_eof
+	_test_eof165: cs = 165; goto _test_eof
+	_test_eof166: cs = 166; goto _test_eof
+	_test_eof167: cs = 167; goto _test_eof
+	_test_eof168: cs = 168; goto _test_eof
+	_test_eof169: cs = 169; goto _test_eof
+	_test_eof170: cs = 170; goto _test_eof
+	_test_eof171: cs = 171; goto _test_eof
+	_test_eof172: cs = 172; goto _test_eof
+	_test_eof173: cs = 173; goto _test_eof
+	_test_eof174: cs = 174; goto _test_eof
+	_test_eof175: cs = 175; goto _test_eof
+	_test_eof176: cs = 176; goto _test_eof
+	_test_eof177: cs = 177; goto _test_eof
+	_test_eof178: cs = 178; goto _test_eof
+	_test_eof179: cs = 179; goto _test_eof
+	_test_eof180: cs = 180; goto _test_eof
+	_test_eof181: cs = 181; goto _test_eof
+	_test_eof182: cs = 182; goto _test_eof
+	_test_eof183: cs = 183; goto _test_eof
+	_test_eof184: cs = 184; goto _test_eof
+	_test_eof185: cs = 185; goto _test_eof
+	_test_eof186: cs = 186; goto _test_eof
+	_test_eof187: cs = 187; goto _test_eof
+	_test_eof188: cs = 188; goto _test_eof
+	_test_eof189: cs = 189; goto _test_eof
+	_test_eof190: cs = 190; goto _test_eof
+	_test_eof191: cs = 191; goto _test_eof
+	_test_eof192: cs = 192; goto _test_eof
+	_test_eof193: cs = 193; goto _test_eof
+	_test_eof194: cs = 194; goto _test_eof
+	_test_eof195: cs = 195; goto _test_eof
+	_test_eof196: cs = 196; goto _test_eof
+	_test_eof197: cs = 197; goto _test_eof
+	_test_eof198: cs = 198; goto _test_eof
+	_test_eof199: cs = 199; goto _test_eof
+	_test_eof200: cs = 200; goto _test_eof
+	_test_eof201: cs = 201; goto _test_eof
+	_test_eof202: cs = 202; goto _test_eof
+	_test_eof203: cs = 203; goto _test_eof
+	_test_eof204: cs = 204; goto _test_eof
+	_test_eof205: cs = 205; goto _test_eof
+	_test_eof206: cs = 206; goto _test_eof
+	_test_eof207: cs = 207; goto _test_eof
+	_test_eof208: cs = 208; goto _test_eof
+	_test_eof209: cs = 209; goto _test_eof
+	_test_eof210: cs = 210; goto _test_eof
+	_test_eof211: cs = 211; goto _test_eof
+	_test_eof212: cs = 212; goto _test_eof
+	_test_eof213: cs = 213; goto _test_eof
+	_test_eof214: cs = 214; goto _test_eof
+	_test_eof215: cs = 215; goto _test_eof
+	_test_eof216: cs = 216; goto _test_eof
+	_test_eof217: cs = 217; goto _test_eof
+	_test_eof218: cs = 218; goto _test_eof
+	_test_eof219: cs = 219; goto _test_eof
+	_test_eof220: cs = 220; goto _test_eof
+	_test_eof221: cs = 221; goto _test_eof
+	_test_eof222: cs = 222; goto _test_eof
+	_test_eof223: cs = 223; goto _test_eof
+	_test_eof224: cs = 224; goto _test_eof
+	_test_eof225: cs = 225; goto _test_eof
+	_test_eof226: cs = 226; goto _test_eof
+	_test_eof227: cs = 227; goto _test_eof
+	_test_eof228: cs = 228; goto _test_eof
+	_test_eof229: cs = 229; goto _test_eof
+	_test_eof230: cs = 230; goto _test_eof
+	_test_eof231: cs = 231; goto _test_eof
+	_test_eof232: cs = 232; goto _test_eof
+	_test_eof233: cs = 233; goto _test_eof
+	_test_eof234: cs = 234; goto _test_eof
+	_test_eof235: cs = 235; goto _test_eof
+	_test_eof236: cs = 236; goto _test_eof
+	_test_eof237: cs = 237; goto _test_eof
+	_test_eof238: cs = 238; goto _test_eof
+	_test_eof239: cs = 239; goto _test_eof
+	_test_eof240: cs = 240; goto _test_eof
+	_test_eof241: cs = 241; goto _test_eof
+	_test_eof242: cs = 242; goto _test_eof
+	_test_eof243: cs = 243; goto _test_eof
+	_test_eof244: cs = 244; goto _test_eof
+	_test_eof245: cs = 245; goto _test_eof
+	_test_eof246: cs = 246; goto _test_eof
+	_test_eof247: cs = 247; goto _test_eof
+	_test_eof248: cs = 248; goto _test_eof
+	_test_eof249: cs = 249; goto _test_eof
+	_test_eof250: cs = 250; goto _test_eof
+	_test_eof251: cs = 251; goto _test_eof
+	_test_eof252: cs = 252; goto _test_eof
+	_test_eof253: cs = 253; goto _test_eof
+	_test_eof254: cs = 254; goto _test_eof
+	_test_eof255: cs = 255; goto _test_eof
+	_test_eof256: cs = 256; goto _test_eof
+	_test_eof257: cs = 257; goto _test_eof
+	_test_eof258: cs = 258; goto _test_eof
+	_test_eof259: cs = 259; goto _test_eof
+	_test_eof260: cs = 260; goto _test_eof
+	_test_eof261: cs = 261; goto _test_eof
+	_test_eof262: cs = 262; goto _test_eof
 
 	_test_eof: {}
 	if p == eof {
 		switch cs {
-		case 127, 128, 141, 142, 143, 144:
-//line parser.rl:25
- uri.params   = str[m:p] 
-		case 129, 145, 146:
-//line parser.rl:26
+		case 290:
+//line parser.rl:29
+ uri.userinfo = str[m:p]; m = p 
+		case 297:
+//line parser.rl:49
  uri.headers  = str[m:p] 
-		case 120, 121, 122, 123, 124, 125, 126, 130, 131, 132, 133, 134, 135, 136, 137, 138, 139, 140, 147, 148, 149:
-//line parser.rl:24
+		case 263, 265, 271, 287, 288, 289:
+//line parser.rl:52
+ uri.spans.add(e-m-1, p-m-1) 
+//line parser.rl:30
+ uri.params   = str[m+1:p] 
+		case 296:
+//line parser.rl:52
+ uri.spans.add(e-m-1, p-m-1) 
+//line parser.rl:48
+ uri.params   = strings.TrimPrefix(str[m:p], ";") 
+		case 292, 293, 294, 298, 299, 300, 301, 302, 303:
+//line parser.rl:47
  uri.hostport = str[m:p] 
-//line parser.rl:20
- m = p 
 //line parser.rl:25
- uri.params   = str[m:p] 
-//line parser_rl.go:4633
+ m = p 
+//line parser.rl:48
+ uri.params   = strings.TrimPrefix(str[m:p], ";") 
+		case 264, 266, 267, 268, 269, 270, 272, 273, 274, 275, 276, 277, 278, 279, 280, 281, 282, 283, 284, 285, 286:
+//line parser.rl:51
+ e = p 
+//line parser.rl:52
+ uri.spans.add(e-m-1, p-m-1) 
+//line parser.rl:30
+ uri.params   = str[m+1:p] 
+		case 295:
+//line parser.rl:51
+ e = p 
+//line parser.rl:52
+ uri.spans.add(e-m-1, p-m-1) 
+//line parser.rl:48
+ uri.params   = strings.TrimPrefix(str[m:p], ";") 
+//line parser_rl.go:9149
 		}
 	}
 
 	_out: {}
 	}
 
-//line parser.rl:62
+//line parser.rl:123
 
 	if cs >= uri_first_final {
 		return uri, nil
 	}
-	return nil, fmt.Errorf("Invalid URI '%s'.", str)
+	return nil, errorAt(str, p)
 }
 
 /* vim: set filetype=go : */
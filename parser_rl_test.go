@@ -17,13 +17,13 @@ func TestRagelParseURISuccess(t *testing.T) {
 			SIP, "alice", "atlanta.com", "", "",
 		}, {
 			"sip:alice:secretword@atlanta.com;transport=tcp",
-			SIP, "alice:secretword", "atlanta.com", ";transport=tcp", "",
+			SIP, "alice:secretword", "atlanta.com", "transport=tcp", "",
 		}, {
 			"sips:alice@atlanta.com?subject=project%20x&priority=urgent",
 			SIPS, "alice", "atlanta.com", "", "subject=project%20x&priority=urgent",
 		}, {
 			"sip:+1-212-555-1212:1234@gateway.com;user=phone",
-			SIP, "+1-212-555-1212:1234", "gateway.com", ";user=phone", "",
+			SIP, "+1-212-555-1212:1234", "gateway.com", "user=phone", "",
 		}, {
 			"sips:gateway.com",
 			SIPS, "", "gateway.com", "", "",
@@ -32,10 +32,10 @@ func TestRagelParseURISuccess(t *testing.T) {
 			SIP, "alice", "192.0.2.4:8899", "", "",
 		}, {
 			"sip:atlanta.com;method=REGISTER?to=alice%40atlanta.com",
-			SIP, "", "atlanta.com", ";method=REGISTER", "to=alice%40atlanta.com",
-			// }, {
-			// 	"sips:alice;day=tuesday@atlanta.com",
-			// 	SIPS, "alice;day=tuesday", "atlanta.com", "", "",
+			SIP, "", "atlanta.com", "method=REGISTER", "to=alice%40atlanta.com",
+		}, {
+			"sips:alice;day=tuesday@atlanta.com",
+			SIPS, "alice;day=tuesday", "atlanta.com", "", "",
 		},
 	}
 
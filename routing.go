@@ -0,0 +1,75 @@
+package uri
+
+// IsLooseRouter returns true if URI has lr parameter of RFC3261 loose
+// router.
+func (uri *URI) IsLooseRouter() bool {
+	return uri.LR()
+}
+
+// RouteSet returns dialog route set from Record-Route header values
+// following RFC3261 #12.1. UAC uses values in reverse order, UAS in the
+// same order. Header parameters are kept.
+func RouteSet(recordRoute []NameAddr, uac bool) []NameAddr {
+	route := make([]NameAddr, len(recordRoute))
+	copy(route, recordRoute)
+	if uac {
+		for i, j := 0, len(route)-1; i < j; i, j = i+1, j-1 {
+			route[i], route[j] = route[j], route[i]
+		}
+	}
+	return route
+}
+
+// PreprocessRoute implements RFC3261 #16.4 Route Information
+// Preprocessing of a proxy. isLocal reports whether URI was placed to
+// Record-Route by this proxy or otherwise indicates this proxy.
+//
+// When Request-URI is local the request was sent by a strict router, so
+// the last Route value is moved to Request-URI. Then the first Route
+// value is removed if it indicates this proxy.
+// Route values without URI, e.g. "*" of ParseAddressList, are skipped.
+// New Route slice is returned, the route argument is not modified.
+func PreprocessRoute(requestURI *URI, route []NameAddr, isLocal func(*URI) bool) (*URI, []NameAddr) {
+	route = copyRoute(route)
+	if requestURI != nil && isLocal(requestURI) && len(route) > 0 {
+		requestURI = route[len(route)-1].URI
+		route = route[:len(route)-1]
+	}
+	if len(route) > 0 && isLocal(route[0].URI) {
+		route = route[1:]
+	}
+	return requestURI, route
+}
+
+// PostprocessRoute implements RFC3261 #16.6 step 6 and #12.2.1.1 request
+// fix-up for strict routing. When the first Route value is not a loose
+// router, Request-URI is appended to the Route and the first Route value
+// without method, maddr, ttl, transport and lr parameters becomes
+// Request-URI. Otherwise Request-URI and Route are returned as is.
+// Route values without URI are skipped, the route argument is not
+// modified.
+func PostprocessRoute(requestURI *URI, route []NameAddr) (*URI, []NameAddr) {
+	route = copyRoute(route)
+	if len(route) == 0 || route[0].URI.IsLooseRouter() {
+		return requestURI, route
+	}
+	next := *route[0].URI
+	params := next.Params()
+	for _, name := range []string{"method", "maddr", "ttl", "transport", "lr"} {
+		params.Del(name)
+	}
+	next.SetParams(params)
+	fixed := append(route[1:], NameAddr{URI: requestURI})
+	return &next, fixed
+}
+
+// copyRoute returns copy of route without values that have no URI.
+func copyRoute(route []NameAddr) []NameAddr {
+	values := make([]NameAddr, 0, len(route))
+	for _, na := range route {
+		if na.URI != nil {
+			values = append(values, na)
+		}
+	}
+	return values
+}
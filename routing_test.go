@@ -0,0 +1,120 @@
+package uri
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func mustParseAddressList(t *testing.T, s string) []NameAddr {
+	list, err := ParseAddressList(s)
+	assert.Nil(t, err, s)
+	return list
+}
+
+func routeString(route []NameAddr) []string {
+	var values []string
+	for _, na := range route {
+		values = append(values, na.String())
+	}
+	return values
+}
+
+func TestIsLooseRouter(t *testing.T) {
+	for input, lr := range map[string]bool{
+		"sip:p1.example.com;lr":            true,
+		"sip:p1.example.com;LR=on":         true,
+		"sip:p1.example.com":               false,
+		"sip:p1.example.com;transport=tcp": false,
+		"sip:lr@p1.example.com?lr=1":       false,
+	} {
+		uri, err := Parse(input)
+		assert.Nil(t, err, input)
+		assert.Equal(t, lr, uri.IsLooseRouter(), input)
+	}
+}
+
+func TestRouteSet(t *testing.T) {
+	rr := mustParseAddressList(t, "<sip:p1.example.com;lr>,<sip:p2.example.com;lr>;x=1,<sip:p3.example.com>")
+	assert.Equal(t, []string{"<sip:p3.example.com>", "<sip:p2.example.com;lr>;x=1", "<sip:p1.example.com;lr>"},
+		routeString(RouteSet(rr, true)))
+	assert.Equal(t, []string{"<sip:p1.example.com;lr>", "<sip:p2.example.com;lr>;x=1", "<sip:p3.example.com>"},
+		routeString(RouteSet(rr, false)))
+	assert.Equal(t, "<sip:p1.example.com;lr>", rr[0].String())
+}
+
+func TestPreprocessRoute(t *testing.T) {
+	isLocal := func(uri *URI) bool {
+		return uri.Host() == "p2.example.com"
+	}
+	tests := []struct {
+		ruri, route string
+		expectRURI  string
+		expectRoute []string
+	}{
+		// loose routing, the first Route value is this proxy
+		{"sip:bob@biloxi.com", "<sip:p2.example.com;lr>,<sip:p3.example.com;lr>",
+			"sip:bob@biloxi.com", []string{"<sip:p3.example.com;lr>"}},
+		// loose routing, Route does not indicate this proxy
+		{"sip:bob@biloxi.com", "<sip:p3.example.com;lr>",
+			"sip:bob@biloxi.com", []string{"<sip:p3.example.com;lr>"}},
+		// previous hop is strict router, last Route value is Request-URI
+		{"sip:p2.example.com;lr", "<sip:p3.example.com;lr>,<sip:bob@biloxi.com>",
+			"sip:bob@biloxi.com", []string{"<sip:p3.example.com;lr>"}},
+		// strict router followed by own first Route value
+		{"sip:p2.example.com;lr", "<sip:p2.example.com;lr>,<sip:bob@biloxi.com>",
+			"sip:bob@biloxi.com", nil},
+	}
+
+	for _, tc := range tests {
+		ruri, _ := Parse(tc.ruri)
+		route := mustParseAddressList(t, tc.route)
+		original := routeString(route)
+
+		ruri, fixed := PreprocessRoute(ruri, route, isLocal)
+		assert.Equal(t, tc.expectRURI, ruri.String(), tc.ruri)
+		assert.Equal(t, tc.expectRoute, routeString(fixed), tc.ruri)
+		assert.Equal(t, original, routeString(route), tc.ruri)
+	}
+
+	ruri, _ := Parse("sip:p2.example.com;lr")
+	ruri, route := PreprocessRoute(ruri, nil, isLocal)
+	assert.Equal(t, "sip:p2.example.com;lr", ruri.String())
+	assert.Empty(t, route)
+
+	// wildcard is not a Route value
+	ruri, route = PreprocessRoute(ruri, mustParseAddressList(t, "*"), isLocal)
+	assert.Equal(t, "sip:p2.example.com;lr", ruri.String())
+	assert.Empty(t, route)
+}
+
+func TestPostprocessRoute(t *testing.T) {
+	ruri, _ := Parse("sip:bob@biloxi.com")
+
+	route := mustParseAddressList(t, "<sip:p1.example.com;lr>,<sip:p2.example.com>")
+	next, fixed := PostprocessRoute(ruri, route)
+	assert.Equal(t, "sip:bob@biloxi.com", next.String())
+	assert.Equal(t, routeString(route), routeString(fixed))
+
+	// RFC3261 #12.2.1.1 example of strict router as the next hop
+	route = mustParseAddressList(t, "<sip:p1.example.com>,<sip:p2.example.com;lr>;x=1")
+	next, fixed = PostprocessRoute(ruri, route)
+	assert.Equal(t, "sip:p1.example.com", next.String())
+	assert.Equal(t, []string{"<sip:p2.example.com;lr>;x=1", "<sip:bob@biloxi.com>"}, routeString(fixed))
+	assert.Equal(t, "<sip:p1.example.com>", route[0].String())
+
+	// parameters not allowed in Request-URI are stripped
+	route = mustParseAddressList(t, "<sip:p1.example.com;transport=tcp;maddr=10.0.0.1;method=INVITE;ttl=1;foo=bar?a=b>")
+	next, fixed = PostprocessRoute(ruri, route)
+	assert.Equal(t, "sip:p1.example.com;foo=bar?a=b", next.String())
+	assert.Equal(t, []string{"<sip:bob@biloxi.com>"}, routeString(fixed))
+	assert.Equal(t, "<sip:p1.example.com;transport=tcp;maddr=10.0.0.1;method=INVITE;ttl=1;foo=bar?a=b>", route[0].String())
+
+	next, fixed = PostprocessRoute(ruri, nil)
+	assert.Equal(t, ruri, next)
+	assert.Empty(t, fixed)
+
+	next, fixed = PostprocessRoute(ruri, mustParseAddressList(t, "*"))
+	assert.Equal(t, ruri, next)
+	assert.Empty(t, fixed)
+}
@@ -0,0 +1,56 @@
+package uri
+
+// Scanner splits sip, sips or tel URI into component tokens with their
+// byte offsets without building URI. Tokens valid so far are returned also
+// for partial and invalid input:
+//
+//	s := uri.NewScanner("sip:alice@atlanta.com;transport=tcp")
+//	for s.Scan() {
+//		fmt.Println(s.Offset(), s.Token(), s.Text())
+//	}
+//	if err := s.Err(); err != nil {
+//		...
+//	}
+//
+// Token text does not include separators, e.g. "@", ":", ";" and "?".
+// When input is invalid the last token is TokenError with the rest of
+// the input starting at the error offset.
+type Scanner struct {
+	lexer *lexer
+	item  Item
+}
+
+// NewScanner returns Scanner for the input.
+func NewScanner(input string) *Scanner {
+	return &Scanner{lexer: newLexer(input)}
+}
+
+// Scan advances to the next token. It returns false at the end of input,
+// also after TokenError.
+func (s *Scanner) Scan() bool {
+	s.item = s.lexer.nextItem()
+	return s.item.token != TokenEOF
+}
+
+// Token returns kind of the current token.
+func (s *Scanner) Token() Token {
+	return s.item.token
+}
+
+// Text returns the current token text.
+func (s *Scanner) Text() string {
+	return s.item.value
+}
+
+// Offset returns byte offset of the current token in the input.
+func (s *Scanner) Offset() int {
+	return s.item.offset
+}
+
+// Err returns *ParseError when input is invalid.
+func (s *Scanner) Err() error {
+	if s.lexer.err == nil {
+		return nil
+	}
+	return s.lexer.err
+}
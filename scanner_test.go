@@ -0,0 +1,112 @@
+package uri
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type scanned struct {
+	offset int
+	token  Token
+	text   string
+}
+
+func scanAll(input string) ([]scanned, error) {
+	var tokens []scanned
+	s := NewScanner(input)
+	for s.Scan() {
+		tokens = append(tokens, scanned{s.Offset(), s.Token(), s.Text()})
+	}
+	return tokens, s.Err()
+}
+
+func TestScanner(t *testing.T) {
+	tests := []struct {
+		input  string
+		tokens []scanned
+	}{
+		{"sip:alice:secret@atlanta.com:5060;transport=tcp?subject=hi", []scanned{
+			{0, TokenSIP, "sip"},
+			{4, TokenUserinfo, "alice:secret"},
+			{17, TokenHost, "atlanta.com"},
+			{29, TokenPort, "5060"},
+			{34, TokenParams, "transport=tcp"},
+			{48, TokenHeaders, "subject=hi"},
+		}},
+		{"sips:[::1]", []scanned{
+			{0, TokenSIPS, "sips"},
+			{5, TokenHost, "[::1]"},
+		}},
+		{"sip:192.0.2.4?to=bob", []scanned{
+			{0, TokenSIP, "sip"},
+			{4, TokenHost, "192.0.2.4"},
+			{14, TokenHeaders, "to=bob"},
+		}},
+		{"tel:+1234567", []scanned{
+			{0, TokenTel, "tel"},
+			{4, TokenNumber, "+1234567"},
+		}},
+		{"TEL:7042;phone-context=example.com", []scanned{
+			{0, TokenTel, "TEL"},
+			{4, TokenNumber, "7042"},
+			{9, TokenParams, "phone-context=example.com"},
+		}},
+	}
+
+	for _, tc := range tests {
+		tokens, err := scanAll(tc.input)
+		assert.Nil(t, err, tc.input)
+		assert.Equal(t, tc.tokens, tokens, tc.input)
+	}
+}
+
+func TestScannerInvalid(t *testing.T) {
+	tests := []struct {
+		input  string
+		tokens []scanned
+		err    error
+	}{
+		{"http://example.com", []scanned{
+			{0, TokenError, "http://example.com"},
+		}, ErrInvalidScheme},
+		{"sip:alice@atlanta.com:50a", []scanned{
+			{0, TokenSIP, "sip"},
+			{4, TokenUserinfo, "alice"},
+			{10, TokenHost, "atlanta.com"},
+			{22, TokenPort, "50"},
+			{24, TokenError, "a"},
+		}, ErrInvalidPort},
+		{"sip:alice@", []scanned{
+			{0, TokenSIP, "sip"},
+			{4, TokenUserinfo, "alice"},
+			{10, TokenError, ""},
+		}, ErrInvalidHostport},
+		{"tel:+1-201;ext=a", []scanned{
+			{0, TokenTel, "tel"},
+			{4, TokenNumber, "+1-201"},
+			{15, TokenError, "a"},
+		}, ErrInvalidParams},
+		{"tel:+1 201", []scanned{
+			{0, TokenTel, "tel"},
+			{6, TokenError, " 201"},
+		}, ErrInvalidNumber},
+	}
+
+	for _, tc := range tests {
+		tokens, err := scanAll(tc.input)
+		assert.Equal(t, tc.tokens, tokens, tc.input)
+		assert.ErrorIs(t, err, tc.err, tc.input)
+
+		var perr *ParseError
+		if assert.ErrorAs(t, err, &perr, tc.input) {
+			assert.Equal(t, tokens[len(tokens)-1].offset, perr.Offset, tc.input)
+		}
+	}
+}
+
+func TestTokenString(t *testing.T) {
+	assert.Equal(t, "host", TokenHost.String())
+	assert.Equal(t, "headers", TokenHeaders.String())
+	assert.Equal(t, "unknown", Token(100).String())
+}
@@ -0,0 +1,379 @@
+package uri
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ErrNotTelephone is returned for URIs that are not tel URIs or sip URIs
+// with user=phone parameter.
+var ErrNotTelephone = errors.New("not a telephone number URI")
+
+// TelephoneSubscriber is telephone-subscriber of tel URI or of sip URI
+// user part with user=phone parameter. Values are unescaped.
+type TelephoneSubscriber struct {
+	Number       string // number as written, global number starts with "+"
+	Global       bool   // number is global E.164 number
+	Ext          string // extension
+	Isub         string // ISDN subaddress
+	Postd        string // post-dial sequence
+	PhoneContext string // context of local number, domain or global number digits
+	Params       Params // other parameters
+}
+
+// Digits returns number without "+" and visual separators.
+func (t *TelephoneSubscriber) Digits() string {
+	return telDigits(strings.TrimPrefix(t.Number, "+"))
+}
+
+// String returns telephone-subscriber in canonical form recommended by
+// RFC3261 #19.1.6: visual separators are removed, case-insensitive parts
+// are lower case, isub and postd are followed by other parameters
+// ordered by name.
+func (t *TelephoneSubscriber) String() string {
+	return string(t.appendTo(nil, isParamChar))
+}
+
+// appendTo appends canonical telephone-subscriber to buf escaping every
+// octet that is not allowed. ";" and "=" are always escaped in values.
+func (t *TelephoneSubscriber) appendTo(buf []byte, allowed func(byte) bool) []byte {
+	value := func(c byte) bool {
+		return c != ';' && c != '=' && allowed(c)
+	}
+	buf = appendEscaped(buf, strings.ToLower(telDigits(t.Number)), value)
+
+	params := make(Params, 0, len(t.Params)+4)
+	if t.Isub != "" {
+		params = append(params, Param{"isub", strings.ToLower(t.Isub)})
+	}
+	if t.Postd != "" {
+		params = append(params, Param{"postd", strings.ToLower(telDigits(t.Postd))})
+	}
+	first := len(params)
+	if t.Ext != "" {
+		params = append(params, Param{"ext", telDigits(t.Ext)})
+	}
+	if t.PhoneContext != "" {
+		context := strings.ToLower(t.PhoneContext)
+		if isGlobalNumber(context) {
+			context = telDigits(context)
+		}
+		params = append(params, Param{"phone-context", context})
+	}
+	for _, p := range t.Params {
+		params = append(params, Param{strings.ToLower(p.Name), p.Value})
+	}
+	rest := params[first:]
+	sort.SliceStable(rest, func(i, j int) bool {
+		return rest[i].Name < rest[j].Name
+	})
+
+	for _, p := range params {
+		buf = append(buf, ';')
+		buf = appendEscaped(buf, p.Name, value)
+		if p.Value != "" {
+			buf = append(buf, '=')
+			buf = appendEscaped(buf, p.Value, value)
+		}
+	}
+	return buf
+}
+
+// ToTel converts tel URI or sip URI with user=phone parameter to tel URI
+// following RFC3261 #19.1.6. Telephone-subscriber is in canonical form,
+// see TelephoneSubscriber.String. Password, URI parameters and headers
+// of sip URI are dropped.
+func (uri *URI) ToTel() (*URI, error) {
+	t, err := uri.TelephoneSubscriber()
+	if err != nil {
+		return nil, err
+	}
+	return strictParser.Parse(string(t.appendTo([]byte("tel:"), isParamChar)))
+}
+
+// TelToSIP converts tel URI to sip URI with user=phone parameter following
+// RFC3261 #19.1.6. Telephone-subscriber is in canonical form, so
+// tel:+358-555-1234567;POSTD=PP22 is converted to
+// sip:+3585551234567;postd=pp22@domain;user=phone. Domain is host with
+// optional port.
+func TelToSIP(tel *URI, domain string) (*URI, error) {
+	if tel.scheme != TEL {
+		return nil, ErrNotTelephone
+	}
+	if !isHostport(domain) {
+		return nil, fmt.Errorf("%w: '%s'", ErrInvalidHostport, domain)
+	}
+	t, err := tel.TelephoneSubscriber()
+	if err != nil {
+		return nil, err
+	}
+	buf := t.appendTo([]byte("sip:"), isUserChar)
+	buf = append(buf, '@')
+	buf = append(buf, domain...)
+	buf = append(buf, ";user=phone"...)
+	return strictParser.Parse(string(buf))
+}
+
+// TelephoneSubscriber parses telephone number of tel URI or of sip URI
+// with user=phone parameter. Password of sip URI is not part of the
+// number and is available with Password.
+// ParseError input is the parsed telephone-subscriber.
+func (uri *URI) TelephoneSubscriber() (*TelephoneSubscriber, error) {
+	switch uri.scheme {
+	case TEL:
+		if uri.params == "" {
+			return parseSubscriber(uri.userinfo, 0)
+		}
+		return parseSubscriber(uri.userinfo+";"+uri.params, 0)
+	case SIP, SIPS:
+		if user, ok := uri.param("user"); ok && strings.EqualFold(user, "phone") {
+			user, _, _ := splitUserinfo(uri.userinfo)
+			return parseSubscriber(user, 0)
+		}
+	}
+	return nil, ErrNotTelephone
+}
+
+// rfc3966 #3 URI Syntax
+// telephone-subscriber = global-number / local-number
+// global-number        = global-number-digits *par
+// local-number         = local-number-digits *par context *par
+// par                  = parameter / extension / isdn-subaddress
+// isdn-subaddress      = ";isub=" 1*uric
+// extension            = ";ext=" 1*phonedigit
+// context              = ";phone-context=" descriptor
+// descriptor           = domainname / global-number-digits
+// parameter            = ";" pname ["=" pvalue ]
+// pname                = 1*( alphanum / "-" )
+// pvalue               = 1*paramchar
+// "#" is not allowed in URI and is escaped as "%23", other octets of
+// number, extension, post-dial and descriptor are not escaped.
+// rfc2806 #2.2 used by rfc3261 telephone-subscriber
+// post-dial            = ";postd=" 1*(phonedigit / dtmf-digit / pause-character)
+//
+// parseSubscriber parses str starting at pos. Failure is reported at
+// the first octet that can not continue telephone-subscriber.
+func parseSubscriber(str string, pos int) (*TelephoneSubscriber, error) {
+	end, ok := scanNumber(str, pos)
+	if !ok {
+		return nil, newParseError(str, end, ErrInvalidNumber)
+	}
+	number := str[pos:end]
+	t := &TelephoneSubscriber{Number: unescape(number), Global: strings.HasPrefix(number, "+")}
+
+	context := false
+	for pos = end + 1; end < len(str); pos = end + 1 {
+		end = pos
+		for end < len(str) && (isAlphaNum(str[end]) || str[end] == '-') {
+			end++
+		}
+		name, value := str[pos:end], ""
+		lower := strings.ToLower(name)
+		// isub, ext, postd and phone-context require value and fail at
+		// "=" when repeated
+		reserved, repeated := true, false
+		switch lower {
+		case "isub":
+			repeated = t.Isub != ""
+		case "ext":
+			repeated = t.Ext != ""
+		case "postd":
+			repeated = t.Postd != ""
+		case "phone-context":
+			repeated = t.Global || context
+		default:
+			reserved = false
+		}
+		hasValue := end < len(str) && str[end] == '='
+		if name == "" || reserved && (!hasValue || repeated) {
+			return nil, newParseError(str, end, ErrInvalidParams)
+		}
+		if hasValue {
+			start := end + 1
+			switch lower {
+			case "isub":
+				end, ok = scanEscaped(str, start, isUricChar)
+			case "ext":
+				end, ok = scanPhonedigits(str, start, false)
+			case "postd":
+				end, ok = scanPostDial(str, start)
+			case "phone-context":
+				end, ok = scanDescriptor(str, start)
+			default:
+				end, ok = scanEscaped(str, start, isParamChar)
+			}
+			value = str[start:end]
+			ok = ok && value != ""
+		}
+		if !ok || end < len(str) && str[end] != ';' {
+			return nil, newParseError(str, end, ErrInvalidParams)
+		}
+		switch lower {
+		case "isub":
+			t.Isub = unescape(value)
+		case "ext":
+			t.Ext = value
+		case "postd":
+			t.Postd = unescape(value)
+		case "phone-context":
+			t.PhoneContext = value
+			context = true
+		default:
+			t.Params = append(t.Params, Param{unescape(name), unescape(value)})
+		}
+	}
+	if !t.Global && !context {
+		return nil, newParseError(str, len(str), ErrInvalidParams)
+	}
+	return t, nil
+}
+
+// hasTelScheme checks case-insensitive "tel:" scheme.
+func hasTelScheme(str string) bool {
+	return len(str) >= 4 && strings.EqualFold(str[:4], "tel:")
+}
+
+// indexParamEnd returns index of the next ";" or end of str.
+func indexParamEnd(str string, pos int) int {
+	if idx := strings.IndexByte(str[pos:], ';'); idx >= 0 {
+		return pos + idx
+	}
+	return len(str)
+}
+
+// global-number-digits = "+" *phonedigit DIGIT *phonedigit
+func isGlobalNumber(s string) bool {
+	return strings.HasPrefix(s, "+") && isPhonedigits(s[1:], false)
+}
+
+// local-number-digits = *phonedigit-hex (HEXDIG / "*" / "#") *phonedigit-hex
+func isLocalNumber(s string) bool {
+	return isPhonedigits(s, true)
+}
+
+// scanNumber returns end of global or local number in s starting at pos
+// and false with offset of the first unexpected octet.
+func scanNumber(s string, pos int) (int, bool) {
+	end, ok := 0, false
+	if strings.HasPrefix(s[pos:], "+") {
+		end, ok = scanPhonedigits(s, pos+1, false)
+	} else {
+		end, ok = scanPhonedigits(s, pos, true)
+	}
+	return end, ok && (end == len(s) || s[end] == ';')
+}
+
+// phonedigit       = DIGIT / [ visual-separator ]
+// phonedigit-hex   = HEXDIG / "*" / "#" / [ visual-separator ]
+// visual-separator = "-" / "." / "(" / ")"
+// isPhonedigits requires at least one digit, "#" must be escaped.
+func isPhonedigits(s string, hex bool) bool {
+	end, ok := scanPhonedigits(s, 0, hex)
+	return ok && end == len(s)
+}
+
+// scanPhonedigits returns end of phonedigits, or phonedigit-hex when hex
+// is true, in s starting at pos. false is returned when there is no
+// digit or "%23" is malformed.
+func scanPhonedigits(s string, pos int, hex bool) (int, bool) {
+	digits := 0
+	for ; pos < len(s); pos++ {
+		switch c := s[pos]; {
+		case isNum(c), hex && (isHex(c) || c == '*'):
+			digits++
+		case hex && c == '%':
+			end, ok := scanHash(s, pos)
+			if !ok {
+				return end, false
+			}
+			pos = end - 1
+			digits++
+		case isVisualSeparator(c):
+		default:
+			return pos, digits > 0
+		}
+	}
+	return pos, digits > 0
+}
+
+// post-dial sequence 1*(phonedigit / dtmf-digit / pause-character)
+// dtmf-digit      = "*" / "#" / "A" / "B" / "C" / "D"
+// pause-character = "p" / "w"
+// Letters are case-insensitive, "#" must be escaped.
+func scanPostDial(s string, pos int) (int, bool) {
+	start := pos
+	for ; pos < len(s); pos++ {
+		switch c := s[pos]; {
+		case isNum(c), isVisualSeparator(c):
+		case c == '*', strings.IndexByte("ABCDPWabcdpw", c) >= 0:
+		case c == '%':
+			end, ok := scanHash(s, pos)
+			if !ok {
+				return end, false
+			}
+			pos = end - 1
+		default:
+			return pos, pos > start
+		}
+	}
+	return pos, pos > start
+}
+
+// scanHash checks "%23" at pos and returns its end or offset of the
+// first unexpected octet.
+func scanHash(s string, pos int) (int, bool) {
+	for i := 1; i < 3; i++ {
+		if pos+i >= len(s) || s[pos+i] != "%23"[i] {
+			return pos + i, false
+		}
+	}
+	return pos + 3, true
+}
+
+// telDigits removes visual separators from telephone number.
+func telDigits(s string) string {
+	if strings.IndexAny(s, "-.()") == -1 {
+		return s
+	}
+	buf := make([]byte, 0, len(s))
+	for i := 0; i < len(s); i++ {
+		if !isVisualSeparator(s[i]) {
+			buf = append(buf, s[i])
+		}
+	}
+	return string(buf)
+}
+
+func isVisualSeparator(c byte) bool {
+	return c == '-' || c == '.' || c == '(' || c == ')'
+}
+
+// domainname = *( domainlabel "." ) toplabel [ "." ]
+func isDomainname(s string) bool {
+	end, ok := scanHostname(s, 0)
+	return ok && end == len(s)
+}
+
+// descriptor = domainname / global-number-digits
+func scanDescriptor(s string, pos int) (int, bool) {
+	if strings.HasPrefix(s[pos:], "+") {
+		return scanPhonedigits(s, pos+1, false)
+	}
+	return scanHostname(s, pos)
+}
+
+// uric = reserved / unreserved / escaped
+// reserved = ";" / "/" / "?" / ":" / "@" / "&" / "=" / "+" / "$" / ","
+// ";" separates parameters so it can be used only escaped.
+func isUricChar(c byte) bool {
+	if isUnreserved(c) {
+		return true
+	}
+	switch c {
+	case '/', '?', ':', '@', '&', '=', '+', '$', ',':
+		return true
+	}
+	return false
+}
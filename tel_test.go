@@ -0,0 +1,206 @@
+package uri
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTel(t *testing.T) {
+	uri, err := Parse("tel:+1-201-555-0123;ext=1234;isub=1411;foo=bar%20baz")
+	assert.Nil(t, err)
+	assert.Equal(t, TEL, uri.Scheme())
+	assert.Equal(t, "tel", uri.Scheme().String())
+	assert.Equal(t, "+1-201-555-0123", uri.User())
+	assert.Equal(t, "", uri.Host())
+	assert.Equal(t, Params{{"ext", "1234"}, {"isub", "1411"}, {"foo", "bar baz"}}, uri.Params())
+	assert.Equal(t, "tel:+1-201-555-0123;ext=1234;isub=1411;foo=bar%20baz", uri.String())
+
+	uri.SetParams(Params{{"phone-context", "example.com"}})
+	assert.Equal(t, "tel:+1-201-555-0123;phone-context=example.com", uri.String())
+
+	uri, err = Parse("tel:+1;isub=a,b/c;postd=pp")
+	assert.Nil(t, err)
+	assert.Equal(t, "tel:+1;isub=a,b/c;postd=pp", uri.String())
+}
+
+func TestParseTelFail(t *testing.T) {
+	tests := []struct {
+		input     string
+		offset    int
+		component Component
+		err       error
+	}{
+		{"tel:", 4, ComponentNumber, ErrInvalidNumber},
+		{"tel:+1 201", 6, ComponentNumber, ErrInvalidNumber},
+		{"tel:+1-(201)-x", 13, ComponentNumber, ErrInvalidNumber},
+		{"tel:+1-201;ext=a", 15, ComponentParams, ErrInvalidParams},
+		{"tel:+1-201;isub", 15, ComponentParams, ErrInvalidParams},
+		{"tel:+1-201;ext=1;;", 17, ComponentParams, ErrInvalidParams},
+		{"tel:+1-201;phone-context=+1", 24, ComponentParams, ErrInvalidParams},
+		{"tel:1234;foo=bar", 16, ComponentParams, ErrInvalidParams},
+		{"tel:1234;phone-context=example.com;phone-context=+1", 48, ComponentParams, ErrInvalidParams},
+		{"TEL:1234;EXT=1;ext=2", 18, ComponentParams, ErrInvalidParams},
+	}
+
+	for _, tc := range tests {
+		uri, err := LexerParse(tc.input)
+		assert.Nil(t, uri, tc.input)
+		assert.Equal(t, &ParseError{tc.input, tc.offset, tc.component, tc.err}, err, tc.input)
+	}
+}
+
+func TestEqualTel(t *testing.T) {
+	tests := []struct {
+		a, b  string
+		equal bool
+	}{
+		{"tel:+1-201-555-0123", "tel:+1(201)5550123", true},
+		{"tel:+1-201-555-0123;ext=1;isub=2", "tel:+12015550123;ISUB=2;ext=1", true},
+		{"tel:7042;phone-context=Example.com", "tel:7042;phone-context=example.com", true},
+		{"tel:*31%23ab;phone-context=example.com", "tel:*31%23AB;phone-context=example.com", true},
+		{"tel:+1-201-555-0123", "tel:+1-201-555-0124", false},
+		{"tel:+1-201-555-0123;ext=1", "tel:+1-201-555-0123", false},
+		{"tel:7042;phone-context=example.com", "tel:7042;phone-context=example.net", false},
+		{"tel:+1-201-555-0123", "sip:+1-201-555-0123@example.com", false},
+	}
+
+	for _, tc := range tests {
+		a, err := Parse(tc.a)
+		assert.Nil(t, err, tc.a)
+		b, err := Parse(tc.b)
+		assert.Nil(t, err, tc.b)
+		assert.Equal(t, tc.equal, a.Equal(b), "%s == %s", tc.a, tc.b)
+		assert.Equal(t, tc.equal, b.Equal(a), "%s == %s", tc.b, tc.a)
+	}
+}
+
+func TestTelephoneSubscriber(t *testing.T) {
+	tests := []struct {
+		input string
+		sub   TelephoneSubscriber
+	}{
+		{"sip:+1-212-555-1212:1234@gateway.com;user=phone",
+			TelephoneSubscriber{Number: "+1-212-555-1212", Global: true}},
+		{"sip:+1-212-555-1212;isub=1411;postd=pp22*%23A@gateway.com;user=PHONE;transport=udp",
+			TelephoneSubscriber{Number: "+1-212-555-1212", Global: true, Isub: "1411", Postd: "pp22*#A"}},
+		{"sips:7042;phone-context=example.com;ext=22;x-foo=a%20b@gateway.com;user=phone",
+			TelephoneSubscriber{Number: "7042", Ext: "22", PhoneContext: "example.com", Params: Params{{"x-foo", "a b"}}}},
+		{"tel:+1-201-555-0123;ext=1234;isub=a1%3B",
+			TelephoneSubscriber{Number: "+1-201-555-0123", Global: true, Ext: "1234", Isub: "a1;"}},
+		{"tel:*31%23;phone-context=example.com",
+			TelephoneSubscriber{Number: "*31#", PhoneContext: "example.com"}},
+		{"tel:863-1234;phone-context=+1-914-555;foo",
+			TelephoneSubscriber{Number: "863-1234", PhoneContext: "+1-914-555", Params: Params{{"foo", ""}}}},
+	}
+
+	for _, tc := range tests {
+		uri, err := Parse(tc.input)
+		if !assert.Nil(t, err, tc.input) {
+			continue
+		}
+		sub, err := uri.TelephoneSubscriber()
+		assert.Nil(t, err, tc.input)
+		assert.Equal(t, &tc.sub, sub, tc.input)
+	}
+
+	uri, _ := Parse("sip:+1-212-555-1212:1234@gateway.com;user=phone")
+	sub, _ := uri.TelephoneSubscriber()
+	assert.Equal(t, "12125551212", sub.Digits())
+	passwd, ok := uri.Password()
+	assert.True(t, ok)
+	assert.Equal(t, "1234", passwd)
+}
+
+func TestTelephoneSubscriberFail(t *testing.T) {
+	tests := []struct {
+		input string
+		err   error
+	}{
+		{"sip:+1-212-555-1212@gateway.com", ErrNotTelephone},
+		{"sip:gateway.com;user=phone", ErrInvalidNumber},
+		{"sip:alice@atlanta.com;user=phone", ErrInvalidNumber},
+		{"sip:1212@gateway.com;user=phone", ErrInvalidParams},
+		{"sip:+1212;postd=x@gateway.com;user=phone", ErrInvalidParams},
+		{"sip:+1212;isub=1;isub=2@gateway.com;user=phone", ErrInvalidParams},
+	}
+
+	for _, tc := range tests {
+		uri, err := Parse(tc.input)
+		assert.Nil(t, err, tc.input)
+		sub, err := uri.TelephoneSubscriber()
+		assert.Nil(t, sub, tc.input)
+		assert.ErrorIs(t, err, tc.err, tc.input)
+	}
+}
+
+func TestTelToSIP(t *testing.T) {
+	tests := []struct {
+		tel, domain, sip string
+	}{
+		{"tel:+358-555-1234567;postd=pp22", "foo.com", "sip:+3585551234567;postd=pp22@foo.com;user=phone"},
+		{"tel:+358-555-1234567;POSTD=PP22", "foo.com", "sip:+3585551234567;postd=pp22@foo.com;user=phone"},
+		{"tel:7042;phone-context=Example.COM;ext=1;isub=X;Foo=Bar", "gw.example.com:5060",
+			"sip:7042;isub=x;ext=1;foo=Bar;phone-context=example.com@gw.example.com:5060;user=phone"},
+		{"tel:*31%23AB;phone-context=+1-914-555", "[::1]", "sip:*31%23ab;phone-context=+1914555@[::1];user=phone"},
+		{"tel:+1-201-555-0123;isub=a%3Bb", "gw", "sip:+12015550123;isub=a%3Bb@gw;user=phone"},
+	}
+
+	for _, tc := range tests {
+		tel, err := Parse(tc.tel)
+		assert.Nil(t, err, tc.tel)
+		uri, err := TelToSIP(tel, tc.domain)
+		if assert.Nil(t, err, tc.tel) {
+			assert.Equal(t, tc.sip, uri.String(), tc.tel)
+		}
+	}
+
+	sip, _ := Parse("sip:alice@atlanta.com")
+	_, err := TelToSIP(sip, "foo.com")
+	assert.ErrorIs(t, err, ErrNotTelephone)
+	tel, _ := Parse("tel:+1-201-555-0123")
+	for _, domain := range []string{"-bad-", "example.com;maddr=evil.com", "example.com?subject=x",
+		"alice@example.com", "example.com:", "example.com:65536", "[::1]x", "[::1"} {
+		_, err = TelToSIP(tel, domain)
+		assert.ErrorIs(t, err, ErrInvalidHostport, domain)
+	}
+}
+
+func TestToTel(t *testing.T) {
+	tests := []struct {
+		input, tel string
+	}{
+		{"sip:+358-555-1234567;POSTD=PP22:secret@foo.com;user=phone;transport=tcp?subject=hi", "tel:+3585551234567;postd=pp22"},
+		{"sips:7042;phone-context=Example.COM;ext=1@gw;user=phone", "tel:7042;ext=1;phone-context=example.com"},
+		{"tel:+1-(201)-555-0123;isub=A1", "tel:+12015550123;isub=a1"},
+		{"tel:*31%23;phone-context=example.com", "tel:*31%23;phone-context=example.com"},
+	}
+
+	for _, tc := range tests {
+		uri, err := Parse(tc.input)
+		assert.Nil(t, err, tc.input)
+		tel, err := uri.ToTel()
+		if assert.Nil(t, err, tc.input) {
+			assert.Equal(t, tc.tel, tel.String(), tc.input)
+		}
+	}
+
+	uri, _ := Parse("sip:+358-555-1234567@foo.com")
+	_, err := uri.ToTel()
+	assert.ErrorIs(t, err, ErrNotTelephone)
+}
+
+func TestTelSIPRoundTrip(t *testing.T) {
+	a, _ := Parse("tel:+358-555-1234567;postd=pp22;isub=1")
+	b, _ := Parse("tel:+358.555.1234567;ISUB=1;POSTD=PP22")
+	sa, err := TelToSIP(a, "foo.com")
+	assert.Nil(t, err)
+	sb, err := TelToSIP(b, "foo.com")
+	assert.Nil(t, err)
+	assert.True(t, sa.Equal(sb))
+
+	tel, err := sa.ToTel()
+	assert.Nil(t, err)
+	assert.True(t, tel.Equal(a))
+	assert.Equal(t, "tel:+3585551234567;isub=1;postd=pp22", tel.String())
+}
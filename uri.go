@@ -1,20 +1,235 @@
 package uri
 
+import (
+	"net"
+	"strings"
+)
+
 // Scheme for sip URI
 type Scheme uint8
 
-// URI schemes sip/sips
+// URI schemes sip/sips and rfc3966 tel
 const (
 	UNKNOWN Scheme = iota
 	SIPS
 	SIP
+	TEL
 )
 
-// URI SIP struct
+// String returns scheme name as it is written in URI.
+func (s Scheme) String() string {
+	switch s {
+	case SIP:
+		return "sip"
+	case SIPS:
+		return "sips"
+	case TEL:
+		return "tel"
+	}
+	return "unknown"
+}
+
+// URI SIP struct. Telephone number of tel URI is stored as userinfo.
 type URI struct {
 	scheme   Scheme
 	userinfo string
 	hostport string
 	params   string
 	headers  string
+
+	spans paramSpans // parameters found in params by parser
+}
+
+// Scheme returns URI scheme.
+func (uri *URI) Scheme() Scheme {
+	return uri.scheme
+}
+
+// User returns unescaped user part of userinfo.
+func (uri *URI) User() string {
+	user, _, _ := splitUserinfo(uri.userinfo)
+	return unescape(user)
+}
+
+// Password returns unescaped password and true if userinfo has password part.
+func (uri *URI) Password() (string, bool) {
+	_, passwd, ok := splitUserinfo(uri.userinfo)
+	return unescape(passwd), ok
+}
+
+// Host returns URI host. IPv6 reference is returned without brackets.
+func (uri *URI) Host() string {
+	host, _ := splitHostport(uri.hostport)
+	if len(host) > 1 && host[0] == '[' && host[len(host)-1] == ']' {
+		return host[1 : len(host)-1]
+	}
+	return host
+}
+
+// IP returns host as net.IP when host is IPv4 address or IPv6 reference,
+// otherwise nil.
+func (uri *URI) IP() net.IP {
+	host, _ := splitHostport(uri.hostport)
+	if len(host) > 1 && host[0] == '[' && host[len(host)-1] == ']' {
+		return ipv6(host[1 : len(host)-1])
+	}
+	return ipv4(host)
+}
+
+// Port returns URI port and true when port is present.
+func (uri *URI) Port() (int, bool) {
+	_, port := splitHostport(uri.hostport)
+	if port == "" {
+		return 0, false
+	}
+	n, c, ok := dtoi(port)
+	if !ok || c != len(port) || n > 0xFFFF {
+		return 0, false
+	}
+	return n, true
+}
+
+// Params returns unescaped URI parameters. Parameters boundaries are
+// recorded by parser, modified list has to be stored back with SetParams.
+func (uri *URI) Params() Params {
+	return unescapeParams(uri.params, uri.spans)
+}
+
+// SetParams replaces URI parameters.
+func (uri *URI) SetParams(params Params) {
+	uri.setParams(params.String())
+}
+
+// setParams sets parameters string not split by parser.
+func (uri *URI) setParams(params string) {
+	uri.params = params
+	uri.spans = scanParams(params)
+}
+
+// iterParams returns iterator over parameters as written in URI.
+func (uri *URI) iterParams() paramIter {
+	return paramIter{s: uri.params, spans: uri.spans}
+}
+
+// param returns unescaped value of the first parameter with the name.
+func (uri *URI) param(name string) (string, bool) {
+	return lookupParam(uri.params, uri.spans, name)
+}
+
+// Headers returns parsed and unescaped URI headers. Modified list has
+// to be stored back with SetHeaders.
+func (uri *URI) Headers() Headers {
+	return parseHeaders(uri.headers)
+}
+
+// SetHeaders replaces URI headers.
+func (uri *URI) SetHeaders(headers Headers) {
+	uri.headers = headers.String()
+}
+
+// String returns URI in wire format.
+func (uri *URI) String() string {
+	size := len(uri.userinfo) + len(uri.hostport) + len(uri.params) + len(uri.headers) + 8
+	return string(uri.AppendTo(make([]byte, 0, size)))
+}
+
+// AppendTo appends URI in wire format to buf and returns extended buffer.
+// Characters not allowed in URI component are escaped.
+func (uri *URI) AppendTo(buf []byte) []byte {
+	buf = append(buf, uri.scheme.String()...)
+	buf = append(buf, ':')
+	if uri.scheme == TEL {
+		buf = append(buf, uri.userinfo...)
+	} else if uri.userinfo != "" {
+		user, passwd, ok := splitUserinfo(uri.userinfo)
+		buf = appendRawEscaped(buf, user, isUserChar)
+		if ok {
+			buf = append(buf, ':')
+			buf = appendRawEscaped(buf, passwd, isPasswordChar)
+		}
+		buf = append(buf, '@')
+	}
+	buf = append(buf, uri.hostport...)
+	if params := strings.TrimPrefix(uri.params, ";"); params != "" {
+		allowed := isParamsChar
+		if uri.scheme == TEL {
+			allowed = isTelParamsChar
+		}
+		buf = append(buf, ';')
+		buf = appendRawEscaped(buf, params, allowed)
+	}
+	if uri.headers != "" {
+		buf = append(buf, '?')
+		buf = appendRawEscaped(buf, uri.headers, isHeadersChar)
+	}
+	return buf
+}
+
+func isParamsChar(c byte) bool {
+	return c == ';' || c == '=' || isParamChar(c)
+}
+
+// isub and postd values of tel URI are uric which is wider than paramchar.
+func isTelParamsChar(c byte) bool {
+	return c == ';' || isUricChar(c)
+}
+
+func isHeadersChar(c byte) bool {
+	return c == '&' || c == '=' || isHeaderChar(c)
+}
+
+// userinfo = ( user / telephone-subscriber ) [ ":" password ]
+// user and telephone-subscriber can not contain ":" so the first
+// colon always starts password.
+func splitUserinfo(userinfo string) (user, passwd string, ok bool) {
+	if idx := strings.IndexByte(userinfo, ':'); idx >= 0 {
+		return userinfo[:idx], userinfo[idx+1:], true
+	}
+	return userinfo, "", false
+}
+
+// hostport = host [ ":" port ]
+// host as IPv6reference keeps brackets.
+func splitHostport(hostport string) (host, port string) {
+	if strings.HasPrefix(hostport, "[") {
+		idx := strings.IndexByte(hostport, ']')
+		if idx == -1 {
+			return hostport, ""
+		}
+		host, hostport = hostport[:idx+1], hostport[idx+1:]
+		if strings.HasPrefix(hostport, ":") {
+			return host, hostport[1:]
+		}
+		return host, ""
+	}
+	if idx := strings.LastIndexByte(hostport, ':'); idx >= 0 {
+		return hostport[:idx], hostport[idx+1:]
+	}
+	return hostport, ""
+}
+
+// ipv4 converts IPv4address to net.IP. Octets may have leading zeros
+// rejected by net.ParseIP.
+func ipv4(s string) net.IP {
+	if n, ok := parseIPv4(s); !ok || n != len(s) {
+		return nil
+	}
+	var b [4]byte
+	for i, octet := range strings.Split(s, ".") {
+		v, _, _ := dtoi(octet)
+		b[i] = byte(v)
+	}
+	return net.IPv4(b[0], b[1], b[2], b[3])
+}
+
+// ipv6 converts IPv6address to net.IP.
+func ipv6(s string) net.IP {
+	if !isIPv6(s) {
+		return nil
+	}
+	idx := strings.LastIndexByte(s, ':')
+	if v4 := ipv4(s[idx+1:]); v4 != nil {
+		s = s[:idx+1] + v4.String()
+	}
+	return net.ParseIP(s)
 }
@@ -0,0 +1,173 @@
+package uri
+
+import (
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestURIAccessors(t *testing.T) {
+	tests := []struct {
+		input           string
+		scheme          Scheme
+		user, password  string
+		hasPassword     bool
+		host            string
+		port            int
+		hasPort         bool
+		params, headers string
+	}{
+		{
+			"sip:alice@atlanta.com",
+			SIP, "alice", "", false, "atlanta.com", 0, false, "", "",
+		}, {
+			"sip:alice:secretword@atlanta.com;transport=tcp",
+			SIP, "alice", "secretword", true, "atlanta.com", 0, false, "transport=tcp", "",
+		}, {
+			"sips:alice@atlanta.com?subject=project%20x&priority=urgent",
+			SIPS, "alice", "", false, "atlanta.com", 0, false, "", "subject=project%20x&priority=urgent",
+		}, {
+			"sip:+1-212-555-1212:1234@gateway.com;user=phone",
+			SIP, "+1-212-555-1212", "1234", true, "gateway.com", 0, false, "user=phone", "",
+		}, {
+			"sip:alice@192.0.2.4:8899",
+			SIP, "alice", "", false, "192.0.2.4", 8899, true, "", "",
+		}, {
+			"sip:%61lice:pa%24%24@[2001:db8::10]:5070;lr",
+			SIP, "alice", "pa$$", true, "2001:db8::10", 5070, true, "lr", "",
+		}, {
+			"sip:bob:@[::1]",
+			SIP, "bob", "", true, "::1", 0, false, "", "",
+		},
+	}
+
+	parsers := map[string]func(string) (*URI, error){
+		"ragel":  RagelParse,
+		"re2go":  Re2GoParse,
+		"lexer":  LexerParse,
+		"dummy":  DummyParser,
+		"regexp": RegexParse,
+	}
+
+	for name, parse := range parsers {
+		for _, tc := range tests {
+			msg := fmt.Sprintf("%s: %s", name, tc.input)
+			uri, err := parse(tc.input)
+			assert.Nil(t, err, msg)
+			assert.Equal(t, tc.scheme, uri.Scheme(), msg)
+			assert.Equal(t, tc.user, uri.User(), msg)
+			passwd, ok := uri.Password()
+			assert.Equal(t, tc.password, passwd, msg)
+			assert.Equal(t, tc.hasPassword, ok, msg)
+			assert.Equal(t, tc.host, uri.Host(), msg)
+			port, ok := uri.Port()
+			assert.Equal(t, tc.port, port, msg)
+			assert.Equal(t, tc.hasPort, ok, msg)
+			assert.Equal(t, tc.params, uri.Params().String(), msg)
+			assert.Equal(t, tc.headers, uri.Headers().String(), msg)
+		}
+	}
+}
+
+func TestSplitHostport(t *testing.T) {
+	tests := []struct {
+		input, host, port string
+	}{
+		{"atlanta.com", "atlanta.com", ""},
+		{"atlanta.com:5060", "atlanta.com", "5060"},
+		{"10.0.0.1:5060", "10.0.0.1", "5060"},
+		{"[::1]", "[::1]", ""},
+		{"[::1]:5060", "[::1]", "5060"},
+		{"[::1", "[::1", ""},
+		{"", "", ""},
+	}
+
+	for _, tc := range tests {
+		host, port := splitHostport(tc.input)
+		assert.Equal(t, tc.host, host)
+		assert.Equal(t, tc.port, port)
+	}
+}
+
+func TestURIIP(t *testing.T) {
+	tests := []struct {
+		input string
+		ip    net.IP
+	}{
+		{"sip:alice@192.0.2.4:5060", net.IPv4(192, 0, 2, 4)},
+		{"sip:alice@010.000.002.004", net.IPv4(10, 0, 2, 4)},
+		{"sip:[2001:db8::10]:5070", net.ParseIP("2001:db8::10")},
+		{"sip:[::ffff:192.0.2.1]", net.IPv4(192, 0, 2, 1)},
+		{"sip:[::ffff:010.0.2.1]", net.IPv4(10, 0, 2, 1)},
+		{"sip:atlanta.com", nil},
+		{"tel:+1-201-555-0123", nil},
+	}
+
+	for _, tc := range tests {
+		uri, err := Parse(tc.input)
+		if assert.NoError(t, err, tc.input) {
+			assert.Equal(t, tc.ip, uri.IP(), tc.input)
+		}
+	}
+}
+
+func TestUnescape(t *testing.T) {
+	assert.Equal(t, "alice", unescape("alice"))
+	assert.Equal(t, "alice@atlanta.com", unescape("alice%40atlanta.com"))
+	assert.Equal(t, "project x", unescape("project%20x"))
+	assert.Equal(t, "100%", unescape("100%"))
+	assert.Equal(t, "%zz", unescape("%zz"))
+	assert.Equal(t, "A", unescape("%41"))
+}
+
+func TestURIString(t *testing.T) {
+	tests := []string{
+		"sip:alice@atlanta.com",
+		"sip:alice:secretword@atlanta.com;transport=tcp",
+		"sips:alice@atlanta.com?subject=project%20x&priority=urgent",
+		"sip:+1-212-555-1212:1234@gateway.com;user=phone",
+		"sips:gateway.com",
+		"sip:alice@192.0.2.4:8899",
+		"sip:atlanta.com;method=REGISTER?to=alice%40atlanta.com",
+		"sip:alice:@[2001:db8::10]:5070;lr;maddr=[::1]",
+		"sip:%61lice%3B@atlanta.com;x=%22?h=[a]/b",
+	}
+
+	parsers := map[string]func(string) (*URI, error){
+		"ragel": RagelParse,
+		"re2go": Re2GoParse,
+	}
+
+	for name, parse := range parsers {
+		for _, input := range tests {
+			msg := fmt.Sprintf("%s: %s", name, input)
+			uri, err := parse(input)
+			assert.Nil(t, err, msg)
+			assert.Equal(t, input, uri.String(), msg)
+
+			again, err := parse(uri.String())
+			assert.Nil(t, err, msg)
+			assert.Equal(t, uri, again, msg)
+		}
+	}
+}
+
+func TestURIStringEscape(t *testing.T) {
+	tests := []struct {
+		uri    *URI
+		output string
+	}{
+		{&URI{scheme: SIP, hostport: "atlanta.com"}, "sip:atlanta.com"},
+		{&URI{scheme: SIP, userinfo: "al ice", hostport: "atlanta.com"}, "sip:al%20ice@atlanta.com"},
+		{&URI{scheme: SIPS, userinfo: "bob:p@ss", hostport: "b.com"}, "sips:bob:p%40ss@b.com"},
+		{&URI{scheme: SIP, hostport: "b.com", params: ";x=a\"b;lr"}, "sip:b.com;x=a%22b;lr"},
+		{&URI{scheme: SIP, hostport: "b.com", headers: "subject=hi there&x=%2"}, "sip:b.com?subject=hi%20there&x=%252"},
+	}
+
+	for _, tc := range tests {
+		assert.Equal(t, tc.output, tc.uri.String())
+		assert.Equal(t, "<"+tc.output, string(tc.uri.AppendTo([]byte("<"))))
+	}
+}